@@ -0,0 +1,222 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"fmt"
+	"io"
+	"math"
+)
+
+// =============================================================================
+// Columnar Batches
+// =============================================================================
+//
+// ReadBatch transposes records into columns. Each field is appended straight
+// from the parsed field boundaries to its column's data buffer, unescaping on
+// the way when needed, so a batch costs a few buffer appends per field and no
+// per-field allocation. The layout is that of an Apache Arrow string array:
+// values back to back, an offsets array, and an LSB-first validity bitmap.
+//
+// =============================================================================
+
+// Batch holds records read by ReadBatch, stored column by column.
+type Batch struct {
+	// Columns has one entry per field. With FieldsPerRecord < 0 it has as
+	// many columns as the widest record; shorter records are null in the rest.
+	Columns []Column
+
+	rows int
+}
+
+// Column holds the values of one field across the records of a Batch.
+//
+// Value j is Data[Offsets[j]:Offsets[j+1]], so Offsets has Len()+1 entries.
+// Bit j of Validity (byte j/8, bit j%8) is set if value j is valid. A value is
+// null if its field is empty and unquoted, is an unquoted \N with
+// Reader.Escape set, or is missing from a short record; a quoted empty field
+// ("") is a valid empty string.
+type Column struct {
+	// Name is the header name of the column in header mode, "" otherwise.
+	Name string
+
+	Data     []byte
+	Offsets  []int32
+	Validity []byte
+
+	nulls int
+}
+
+// Len returns the number of records in the batch.
+func (b *Batch) Len() int {
+	return b.rows
+}
+
+// Value returns value j of the column. The slice aliases Data.
+func (c *Column) Value(j int) []byte {
+	return c.Data[c.Offsets[j]:c.Offsets[j+1]]
+}
+
+// IsValid reports whether value j of the column is not null.
+func (c *Column) IsValid(j int) bool {
+	return c.Validity[j>>3]&(1<<(j&7)) != 0
+}
+
+// NullCount returns the number of null values in the column.
+func (c *Column) NullCount() int {
+	return c.nulls
+}
+
+// ReadBatch reads up to n records into a Batch. It returns fewer records only
+// at the end of the input, and io.EOF once no record is left.
+//
+// Comment lines are skipped and FieldsPerRecord and OnError apply as for
+// Read. If a record fails, ReadBatch returns the records before it with the
+// error, and the next call continues after the failing record; FieldPos then
+// reports the positions of the failing record. A column's Data must stay
+// below 2 GiB.
+//
+// The Batch owns its buffers and does not alias the input. With ReuseRecord,
+// the Batch and its buffers are reused by the next call to ReadBatch.
+func (r *Reader) ReadBatch(n int) (*Batch, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("simdcsv: ReadBatch size %d must be positive", n)
+	}
+	if err := r.ensureInitialized(); err != nil {
+		return nil, err
+	}
+
+	b := r.allocateBatch()
+	for b.rows < n {
+		row, _, err := r.nextRow()
+		if err == io.EOF {
+			if b.rows > 0 {
+				break
+			}
+			return nil, err
+		}
+		if err != nil {
+			return b, err
+		}
+		if err := r.appendBatchRow(b, row); err != nil {
+			if skip, err := r.skipRecord(err); !skip {
+				return b, err
+			}
+		}
+	}
+	return b, nil
+}
+
+// allocateBatch returns an empty Batch, reusing the previous one if ReuseRecord is enabled.
+func (r *Reader) allocateBatch() *Batch {
+	if !r.ReuseRecord || r.state.lastBatch == nil {
+		b := &Batch{}
+		if r.ReuseRecord {
+			r.state.lastBatch = b
+		}
+		return b
+	}
+	// addColumn picks up the buffers of the dropped columns
+	b := r.state.lastBatch
+	b.Columns = b.Columns[:0]
+	b.rows = 0
+	return b
+}
+
+// appendBatchRow appends one record to b. On error, b is left as it was.
+func (r *Reader) appendBatchRow(b *Batch, row rowInfo) error {
+	fields := r.getFieldsForRow(row, row.fieldCount)
+	r.state.fieldPositions = r.ensureFieldPositionsCapacity(len(fields))
+	for i, field := range fields {
+		r.state.fieldPositions[i] = position{line: row.lineNum, column: r.inputColumn(uint64(field.rawStart()))}
+	}
+
+	columns := len(b.Columns)
+	for len(b.Columns) < len(fields) {
+		b.addColumn(r.state.header)
+	}
+	for i, field := range fields {
+		if err := r.validateFieldIfNeeded(field, row.lineNum); err != nil {
+			b.truncate(columns)
+			return err
+		}
+		c := &b.Columns[i]
+		if r.isNullField(field) {
+			c.appendOffset(b.rows, false)
+			continue
+		}
+		content, transform := r.fieldContent(field)
+		if transform {
+			c.Data = r.appendTransformed(c.Data, content)
+		} else {
+			c.Data = append(c.Data, content...)
+		}
+		c.appendOffset(b.rows, len(content) > 0 || field.flags&fieldFlagIsQuoted != 0)
+	}
+	for i := len(fields); i < len(b.Columns); i++ {
+		b.Columns[i].appendOffset(b.rows, false)
+	}
+
+	if err := r.finishRecord(len(fields), row); err != nil {
+		b.truncate(columns)
+		return err
+	}
+	for i := range b.Columns {
+		if len(b.Columns[i].Data) > math.MaxInt32 {
+			b.truncate(columns)
+			return fmt.Errorf("simdcsv: batch column %d exceeds 2 GiB", i)
+		}
+	}
+	b.rows++
+	return nil
+}
+
+// addColumn appends a column whose values are null for the records already in b.
+func (b *Batch) addColumn(header []string) {
+	var c Column
+	if len(b.Columns) < cap(b.Columns) {
+		// Reuse the buffers of a column dropped by allocateBatch or truncate
+		c = b.Columns[:len(b.Columns)+1][len(b.Columns)]
+		c = Column{Data: c.Data[:0], Offsets: c.Offsets[:0], Validity: c.Validity[:0]}
+	}
+	if i := len(b.Columns); i < len(header) {
+		c.Name = header[i]
+	}
+	c.Offsets = append(c.Offsets, 0)
+	for j := range b.rows {
+		c.appendOffset(j, false)
+	}
+	b.Columns = append(b.Columns, c)
+}
+
+// appendOffset ends value j at the current end of Data.
+func (c *Column) appendOffset(j int, valid bool) {
+	c.Offsets = append(c.Offsets, int32(len(c.Data))) //nolint:gosec // G115: checked against MaxInt32 per record
+	if j>>3 >= len(c.Validity) {
+		c.Validity = append(c.Validity, 0)
+	}
+	if valid {
+		c.Validity[j>>3] |= 1 << (j & 7)
+	} else {
+		c.nulls++
+	}
+}
+
+// truncate drops a partially appended record, keeping the first columns columns.
+func (b *Batch) truncate(columns int) {
+	b.Columns = b.Columns[:columns]
+	for i := range b.Columns {
+		c := &b.Columns[i]
+		if len(c.Offsets) > b.rows+1 {
+			if !c.IsValid(b.rows) {
+				c.nulls--
+			}
+			c.Offsets = c.Offsets[:b.rows+1]
+		}
+		c.Data = c.Data[:c.Offsets[b.rows]]
+		c.Validity = c.Validity[:(b.rows+7)>>3]
+		if b.rows&7 != 0 {
+			c.Validity[b.rows>>3] &= 1<<(b.rows&7) - 1
+		}
+	}
+}
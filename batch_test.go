@@ -0,0 +1,160 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// =============================================================================
+// Columnar Batch Tests
+// =============================================================================
+
+// batchRows reconstructs the records of b, with "<null>" for null values.
+func batchRows(b *Batch) [][]string {
+	rows := make([][]string, b.Len())
+	for j := range rows {
+		for i := range b.Columns {
+			c := &b.Columns[i]
+			if c.IsValid(j) {
+				rows[j] = append(rows[j], string(c.Value(j)))
+			} else {
+				rows[j] = append(rows[j], "<null>")
+			}
+		}
+	}
+	return rows
+}
+
+// TestReadBatch_MatchesRead verifies batches hold the same values as Read across windows.
+func TestReadBatch_MatchesRead(t *testing.T) {
+	input := generateWindowedCSV(3*4096) + "\"quoted \"\"q\"\"\",x,\n\"multi\r\nline\",\"\",z\n,y,\n"
+	want, err := NewReader(strings.NewReader(input)).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll error: %v", err)
+	}
+
+	for _, reuse := range []bool{false, true} {
+		r := NewReaderWithOptions(strings.NewReader(input), ReaderOptions{BufferSize: 4096})
+		r.ReuseRecord = reuse
+		var got [][]string
+		for {
+			b, err := r.ReadBatch(7)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("ReadBatch error: %v", err)
+			}
+			if b.Len() == 0 || b.Len() > 7 {
+				t.Fatalf("batch of %d records, want 1..7", b.Len())
+			}
+			got = append(got, batchRows(b)...)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("ReuseRecord=%v: got %d records, want %d", reuse, len(got), len(want))
+		}
+		for j := range want {
+			for i, v := range want[j] {
+				if g := got[j][i]; g != v && (g != "<null>" || v != "") {
+					t.Fatalf("ReuseRecord=%v: record %d field %d = %q, want %q", reuse, j, i, g, v)
+				}
+			}
+		}
+	}
+}
+
+// TestReadBatch_Validity tests null values, header names and ragged records.
+func TestReadBatch_Validity(t *testing.T) {
+	input := "id,name,note\n1,,\"\"\n2,bob\n3,carol,x,extra\n"
+	r := NewReaderWithOptions(strings.NewReader(input), ReaderOptions{Header: true})
+	r.FieldsPerRecord = -1
+
+	b, err := r.ReadBatch(10)
+	if err != nil {
+		t.Fatalf("ReadBatch error: %v", err)
+	}
+	want := [][]string{
+		{"1", "<null>", "", "<null>"},
+		{"2", "bob", "<null>", "<null>"},
+		{"3", "carol", "x", "extra"},
+	}
+	if got := batchRows(b); !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %q, want %q", got, want)
+	}
+	for i, name := range []string{"id", "name", "note", ""} {
+		if b.Columns[i].Name != name {
+			t.Errorf("Columns[%d].Name = %q, want %q", i, b.Columns[i].Name, name)
+		}
+	}
+	for i, nulls := range []int{0, 1, 1, 2} {
+		if got := b.Columns[i].NullCount(); got != nulls {
+			t.Errorf("Columns[%d].NullCount() = %d, want %d", i, got, nulls)
+		}
+	}
+	if _, err := r.ReadBatch(10); err != io.EOF {
+		t.Errorf("ReadBatch at end = %v, want io.EOF", err)
+	}
+}
+
+// TestReadBatch_EscapeNull tests that MySQL's \N is null with Escape set.
+func TestReadBatch_EscapeNull(t *testing.T) {
+	input := `1,\N,"\N"` + "\n" + `2,a\N,\\N` + "\n"
+	r := NewReader(strings.NewReader(input))
+	r.Escape = '\\'
+
+	b, err := r.ReadBatch(10)
+	if err != nil {
+		t.Fatalf("ReadBatch error: %v", err)
+	}
+	want := [][]string{
+		{"1", "<null>", ""},
+		{"2", "aN", `\N`},
+	}
+	if got := batchRows(b); !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %q, want %q", got, want)
+	}
+	if got := b.Columns[1].NullCount(); got != 1 {
+		t.Errorf("Columns[1].NullCount() = %d, want 1", got)
+	}
+}
+
+// TestReadBatch_Errors tests that a failing record ends the batch and is not included.
+func TestReadBatch_Errors(t *testing.T) {
+	r := NewReader(strings.NewReader("a,b\nc,d\ne\nf,g\nh,\"i\"x\nj,k\n"))
+
+	b, err := r.ReadBatch(10)
+	if !errors.Is(err, ErrFieldCount) {
+		t.Fatalf("ReadBatch error = %v, want ErrFieldCount", err)
+	}
+	if got, want := batchRows(b), [][]string{{"a", "b"}, {"c", "d"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("rows before error = %q, want %q", got, want)
+	}
+
+	b, err = r.ReadBatch(10)
+	if !errors.Is(err, ErrQuote) {
+		t.Fatalf("ReadBatch error = %v, want ErrQuote", err)
+	}
+	if got, want := batchRows(b), [][]string{{"f", "g"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("rows before error = %q, want %q", got, want)
+	}
+	if c := &b.Columns[1]; len(c.Data) != 1 || len(c.Offsets) != 2 || c.NullCount() != 0 {
+		t.Errorf("partial record left in column: Data %q, Offsets %v", c.Data, c.Offsets)
+	}
+
+	b, err = r.ReadBatch(10)
+	if err != nil {
+		t.Fatalf("ReadBatch error: %v", err)
+	}
+	if got, want := batchRows(b), [][]string{{"j", "k"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("rows after errors = %q, want %q", got, want)
+	}
+
+	if _, err := r.ReadBatch(0); err == nil {
+		t.Error("ReadBatch(0) succeeded, want error")
+	}
+}
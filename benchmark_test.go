@@ -376,28 +376,28 @@ func BenchmarkParseBytes_EscapedQuotes_10K(b *testing.B) {
 func BenchmarkFindClosingQuote_Short(b *testing.B) {
 	input := []byte(`"hello world"`)
 	for b.Loop() {
-		findClosingQuote(input, 1)
+		findClosingQuote(input, 1, '"')
 	}
 }
 
 func BenchmarkFindClosingQuote_Long(b *testing.B) {
 	input := []byte(`"` + strings.Repeat("abcdefgh", 100) + `"`)
 	for b.Loop() {
-		findClosingQuote(input, 1)
+		findClosingQuote(input, 1, '"')
 	}
 }
 
 func BenchmarkFindClosingQuote_LongScalar(b *testing.B) {
 	input := []byte(`"` + strings.Repeat("abcdefgh", 100) + `"`)
 	for b.Loop() {
-		findClosingQuoteScalar(input, 1)
+		findClosingQuoteScalar(input, 1, '"')
 	}
 }
 
 func BenchmarkFindClosingQuote_LongWithEscapes(b *testing.B) {
 	input := []byte(`"` + strings.Repeat(`a""b`, 50) + `"`)
 	for b.Loop() {
-		findClosingQuote(input, 1)
+		findClosingQuote(input, 1, '"')
 	}
 }
 
@@ -663,7 +663,7 @@ func BenchmarkGenerateMasks(b *testing.B) {
 
 	b.ResetTimer()
 	for b.Loop() {
-		generateMasks(data, ',')
+		generateMasks(data, ',', '"')
 	}
 }
 
@@ -683,7 +683,7 @@ func BenchmarkGenerateMasksPadded(b *testing.B) {
 
 			b.ResetTimer()
 			for b.Loop() {
-				generateMasksPadded(data, ',')
+				generateMasksPadded(data, ',', '"')
 			}
 		})
 	}
@@ -710,7 +710,7 @@ func BenchmarkScanBuffer(b *testing.B) {
 			b.ResetTimer()
 			b.SetBytes(int64(size))
 			for b.Loop() {
-				scanBuffer(data, ',')
+				scanBuffer(data, ',', '"')
 			}
 		})
 	}
@@ -0,0 +1,295 @@
+// Code generated by simdcsv-gen. DO NOT EDIT.
+
+//go:build goexperiment.simd && amd64
+
+package simdcsv_test
+
+import (
+	"fmt"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"time"
+
+	simdcsv "github.com/nnnkkk7/go-simdcsv"
+)
+
+// bindRecordCSVColumns lists the columns bound to the fields of bindRecord.
+var bindRecordCSVColumns = []string{"id", "name", "level", "active", "small", "count", "age", "ratio", "price", "addr", "code", "alt", "born", "seen", "Extra"}
+
+// CSVHeader returns the column names of bindRecord in field order.
+func (*bindRecord) CSVHeader() []string {
+	return []string{"id", "name", "level", "active", "small", "count", "age", "ratio", "price", "addr", "code", "alt", "born", "seen", "Extra"}
+}
+
+// DecodeCSV stores row in t, matching columns like simdcsv.Decoder.
+func (t *bindRecord) DecodeCSV(row *simdcsv.Row) error {
+	b := simdcsv.BindRow(row, bindRecordCSVColumns)
+	if c := b.Column(0); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		x, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return b.Error(c, "ID", reflect.TypeFor[int64](), err)
+		}
+		t.bindBase.ID = x
+	}
+	if c := b.Column(1); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		t.Name = v
+	}
+	if c := b.Column(2); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		t.Level = bindLevel(v)
+	}
+	if c := b.Column(3); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		x, err := strconv.ParseBool(v)
+		if err != nil {
+			return b.Error(c, "Active", reflect.TypeFor[bool](), err)
+		}
+		t.Active = x
+	}
+	if c := b.Column(4); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		x, err := strconv.ParseInt(v, 10, 8)
+		if err != nil {
+			return b.Error(c, "Small", reflect.TypeFor[int8](), err)
+		}
+		t.Small = int8(x)
+	}
+	if c := b.Column(5); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		if v == "" {
+			t.Count = 0
+		} else {
+			x, err := strconv.ParseInt(v, 10, strconv.IntSize)
+			if err != nil {
+				return b.Error(c, "Count", reflect.TypeFor[int](), err)
+			}
+			t.Count = int(x)
+		}
+	}
+	if c := b.Column(6); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		if v == "" {
+			t.Age = nil
+		} else {
+			p := new(uint8)
+			x, err := strconv.ParseUint(v, 10, 8)
+			if err != nil {
+				return b.Error(c, "Age", reflect.TypeFor[*uint8](), err)
+			}
+			*p = uint8(x)
+			t.Age = p
+		}
+	}
+	if c := b.Column(7); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		x, err := strconv.ParseFloat(v, 32)
+		if err != nil {
+			return b.Error(c, "Ratio", reflect.TypeFor[float32](), err)
+		}
+		t.Ratio = float32(x)
+	}
+	if c := b.Column(8); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		x, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return b.Error(c, "Price", reflect.TypeFor[float64](), err)
+		}
+		t.Price = x
+	}
+	if c := b.Column(9); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		if err := t.Addr.UnmarshalText([]byte(v)); err != nil {
+			return b.Error(c, "Addr", reflect.TypeFor[netip.Addr](), err)
+		}
+	}
+	if c := b.Column(10); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		if err := t.Code.UnmarshalText([]byte(v)); err != nil {
+			return b.Error(c, "Code", reflect.TypeFor[bindCode](), err)
+		}
+	}
+	if c := b.Column(11); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		if v == "" {
+			t.Alt = nil
+		} else {
+			p := new(bindCode)
+			if err := p.UnmarshalText([]byte(v)); err != nil {
+				return b.Error(c, "Alt", reflect.TypeFor[*bindCode](), err)
+			}
+			t.Alt = p
+		}
+	}
+	if c := b.Column(12); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		x, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return b.Error(c, "Born", reflect.TypeFor[time.Time](), err)
+		}
+		t.Born = x
+	}
+	if c := b.Column(13); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		if v == "" {
+			t.Seen = nil
+		} else {
+			p := new(time.Time)
+			x, err := time.Parse("2006-01-02T15:04:05Z07:00", v)
+			if err != nil {
+				return b.Error(c, "Seen", reflect.TypeFor[*time.Time](), err)
+			}
+			*p = x
+			t.Seen = p
+		}
+	}
+	if c := b.Column(14); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		t.Extra = v
+	}
+	return nil
+}
+
+// AppendCSV writes t to w as one record, formatted like simdcsv.Encoder.
+func (t *bindRecord) AppendCSV(w *simdcsv.Writer) error {
+	addrText, err := t.Addr.MarshalText()
+	if err != nil {
+		return fmt.Errorf("simdcsv: encoding field Addr: %w", err)
+	}
+	codeText, err := t.Code.MarshalText()
+	if err != nil {
+		return fmt.Errorf("simdcsv: encoding field Code: %w", err)
+	}
+	var altText []byte
+	if t.Alt != nil {
+		b, err := t.Alt.MarshalText()
+		if err != nil {
+			return fmt.Errorf("simdcsv: encoding field Alt: %w", err)
+		}
+		altText = b
+	}
+	if err := w.BeginRecord(); err != nil {
+		return err
+	}
+	w.WriteInt(t.bindBase.ID)
+	w.WriteField(t.Name)
+	w.WriteField(string(t.Level))
+	w.WriteBool(t.Active)
+	w.WriteInt(int64(t.Small))
+	if t.Count == 0 {
+		w.WriteField("")
+	} else {
+		w.WriteInt(int64(t.Count))
+	}
+	if t.Age == nil {
+		w.WriteField("")
+	} else {
+		w.WriteUint(uint64(*t.Age))
+	}
+	w.WriteFloat(float64(t.Ratio), -1, 32)
+	w.WriteFloat(t.Price, 2, 64)
+	w.WriteFieldBytes(addrText)
+	w.WriteFieldBytes(codeText)
+	w.WriteFieldBytes(altText)
+	w.WriteTime(t.Born, "2006-01-02")
+	if t.Seen == nil {
+		w.WriteField("")
+	} else {
+		w.WriteTime(*t.Seen, "2006-01-02T15:04:05Z07:00")
+	}
+	w.WriteField(t.Extra)
+	return w.EndRecord()
+}
+
+// bindPointCSVColumns lists the columns bound to the fields of bindPoint.
+var bindPointCSVColumns = []string{"X", "Y"}
+
+// CSVHeader returns the column names of bindPoint in field order.
+func (*bindPoint) CSVHeader() []string {
+	return []string{"X", "Y"}
+}
+
+// DecodeCSV stores row in t, matching columns like simdcsv.Decoder.
+func (t *bindPoint) DecodeCSV(row *simdcsv.Row) error {
+	b := simdcsv.BindRow(row, bindPointCSVColumns)
+	if c := b.Column(0); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		x, err := strconv.ParseUint(v, 10, 16)
+		if err != nil {
+			return b.Error(c, "X", reflect.TypeFor[uint16](), err)
+		}
+		t.X = uint16(x)
+	}
+	if c := b.Column(1); c >= 0 {
+		v, err := b.Value(c)
+		if err != nil {
+			return err
+		}
+		x, err := strconv.ParseUint(v, 10, 16)
+		if err != nil {
+			return b.Error(c, "Y", reflect.TypeFor[uint16](), err)
+		}
+		t.Y = uint16(x)
+	}
+	return nil
+}
+
+// AppendCSV writes t to w as one record, formatted like simdcsv.Encoder.
+func (t *bindPoint) AppendCSV(w *simdcsv.Writer) error {
+	if err := w.BeginRecord(); err != nil {
+		return err
+	}
+	w.WriteUint(uint64(t.X))
+	w.WriteUint(uint64(t.Y))
+	return w.EndRecord()
+}
@@ -0,0 +1,179 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/netip"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	simdcsv "github.com/nnnkkk7/go-simdcsv"
+)
+
+//go:generate go run ./cmd/simdcsv-gen -type=bindRecord,bindPoint -output=bind_gen_test.go
+
+// =============================================================================
+// Generated Binding Tests
+// =============================================================================
+
+// bindLevel is a local string type converted without reflection.
+type bindLevel string
+
+// bindCode is a local text type with pointer-receiver methods.
+type bindCode struct{ v string }
+
+func (c *bindCode) UnmarshalText(text []byte) error {
+	if len(text) == 0 || text[0] != '#' {
+		return fmt.Errorf("code %q lacks '#'", text)
+	}
+	c.v = string(text[1:])
+	return nil
+}
+
+func (c *bindCode) MarshalText() ([]byte, error) {
+	return []byte("#" + c.v), nil
+}
+
+type bindBase struct {
+	ID int64 `csv:"id"`
+}
+
+type bindRecord struct {
+	bindBase
+	Name    string     `csv:"name"`
+	Level   bindLevel  `csv:"level"`
+	Active  bool       `csv:"active"`
+	Small   int8       `csv:"small"`
+	Count   int        `csv:"count,omitempty"`
+	Age     *uint8     `csv:"age"`
+	Ratio   float32    `csv:"ratio"`
+	Price   float64    `csv:"price,prec=2"`
+	Addr    netip.Addr `csv:"addr"`
+	Code    bindCode   `csv:"code"`
+	Alt     *bindCode  `csv:"alt"`
+	Born    time.Time  `csv:"born,layout=2006-01-02"`
+	Seen    *time.Time `csv:"seen"`
+	Ignored string     `csv:"-"`
+	Extra   string
+	private string
+}
+
+type bindPoint struct {
+	X, Y uint16
+}
+
+// bindInput has columns in a different order from bindRecord, an unknown
+// column, a missing column (alt) and a record with a conversion error.
+const bindInput = "seen,Extra,name,id,level,active,small,count,age,ratio,price,addr,code,born,unused\n" +
+	"2024-05-01T10:00:00Z,x,\"Smith, \"\"J\"\"\",7,high,true,-3,,42,0.25,9.5,10.0.0.1,#ab,1990-02-03,u\n" +
+	",,bob,8,low,false,127,12,,1e-7,0,::1,#,2000-01-01,\n" +
+	",,eve,9,,true,0,1,,0,0,::1,nope,2000-01-01,\n"
+
+// decodeAll decodes records with decode until io.EOF or the first error.
+func decodeAll[T any](t *testing.T, decode func(*T) error) ([]T, error) {
+	t.Helper()
+	var out []T
+	for {
+		var v T
+		err := decode(&v)
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return out, err
+		}
+		out = append(out, v)
+	}
+}
+
+// TestGenerated_DecodeMatchesDecoder verifies generated DecodeCSV matches the reflection Decoder.
+func TestGenerated_DecodeMatchesDecoder(t *testing.T) {
+	for _, opts := range []simdcsv.ReaderOptions{{Header: true}, {Header: true, ZeroCopy: true, BufferSize: 4096}} {
+		dec := simdcsv.NewDecoder(simdcsv.NewReaderWithOptions(strings.NewReader(bindInput), opts))
+		want, wantErr := decodeAll(t, func(v *bindRecord) error { return dec.Decode(v) })
+
+		r := simdcsv.NewReaderWithOptions(strings.NewReader(bindInput), opts)
+		got, gotErr := decodeAll(t, func(v *bindRecord) error {
+			row, err := r.ReadRow()
+			if err != nil {
+				return err
+			}
+			return v.DecodeCSV(row)
+		})
+
+		if len(want) != 2 || wantErr == nil {
+			t.Fatalf("Decoder: %d records, error %v; want 2 records and a conversion error", len(want), wantErr)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%+v: DecodeCSV = %+v, Decoder = %+v", opts, got, want)
+		}
+		if fmt.Sprint(gotErr) != fmt.Sprint(wantErr) {
+			t.Errorf("%+v: DecodeCSV error %v, Decoder error %v", opts, gotErr, wantErr)
+		}
+	}
+}
+
+// TestGenerated_DecodePositional verifies positional binding without header mode.
+func TestGenerated_DecodePositional(t *testing.T) {
+	const input = "1,2\n3,x\n"
+	dec := simdcsv.NewDecoder(simdcsv.NewReader(strings.NewReader(input)))
+	want, wantErr := decodeAll(t, func(v *bindPoint) error { return dec.Decode(v) })
+
+	r := simdcsv.NewReader(strings.NewReader(input))
+	got, gotErr := decodeAll(t, func(v *bindPoint) error {
+		row, err := r.ReadRow()
+		if err != nil {
+			return err
+		}
+		return v.DecodeCSV(row)
+	})
+	if !reflect.DeepEqual(got, want) || fmt.Sprint(gotErr) != fmt.Sprint(wantErr) {
+		t.Errorf("DecodeCSV = %+v, %v; Decoder = %+v, %v", got, gotErr, want, wantErr)
+	}
+}
+
+// TestGenerated_AppendMatchesEncoder verifies generated AppendCSV writes the same bytes as the reflection Encoder.
+func TestGenerated_AppendMatchesEncoder(t *testing.T) {
+	age := uint8(42)
+	seen := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	records := []bindRecord{
+		{
+			bindBase: bindBase{ID: 7}, Name: "Smith, \"J\"\nJr", Level: " high", Active: true, Small: -3, Age: &age,
+			Ratio: 0.25, Price: 9.499, Addr: netip.MustParseAddr("10.0.0.1"), Code: bindCode{"ab"}, Alt: &bindCode{"c,d"},
+			Born: time.Date(1990, 2, 3, 0, 0, 0, 0, time.UTC), Seen: &seen, Extra: "x",
+		},
+		{bindBase: bindBase{ID: -8}, Count: 12, Ratio: 1e-7, Price: 1e21},
+	}
+
+	for _, delim := range []string{"", "||"} {
+		var want bytes.Buffer
+		w := simdcsv.NewWriter(&want)
+		w.Delimiter = delim
+		if err := simdcsv.NewEncoder(w).Encode(records); err != nil {
+			t.Fatalf("Encode error: %v", err)
+		}
+		w.Flush()
+
+		var got bytes.Buffer
+		w = simdcsv.NewWriter(&got)
+		w.Delimiter = delim
+		if err := w.Write((*bindRecord).CSVHeader(nil)); err != nil {
+			t.Fatalf("Write header error: %v", err)
+		}
+		for i := range records {
+			if err := records[i].AppendCSV(w); err != nil {
+				t.Fatalf("AppendCSV error: %v", err)
+			}
+		}
+		w.Flush()
+
+		if got.String() != want.String() {
+			t.Errorf("delimiter %q: AppendCSV output:\n%s\nEncoder output:\n%s", delim, got.String(), want.String())
+		}
+	}
+}
@@ -0,0 +1,95 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// =============================================================================
+// Generated Code Support
+// =============================================================================
+//
+// Code generated by simdcsv-gen decodes a Row through a RowBinding rather than
+// through methods of Row, so the hooks it needs stay out of the Row API. The
+// generator and RowBinding change together; generated code is regenerated
+// rather than written by hand.
+//
+// In header mode the columns of a names slice are resolved once per header
+// and cached by the Reader, keyed by the slice. Generated code passes a
+// package-level slice per struct type, so the cache holds one entry per type;
+// it is bounded by maxColumnBindings and dropped with the header on Reset.
+//
+// =============================================================================
+
+// maxColumnBindings bounds the column bindings cached by a Reader. Names
+// slices beyond it are resolved on every call.
+const maxColumnBindings = 16
+
+// RowBinding binds named columns to the fields of a Row. It supports code
+// generated by simdcsv-gen and is not meant to be used directly; its methods
+// follow the needs of the generator.
+type RowBinding struct {
+	row     *Row
+	columns []int // column of each name in header mode, nil otherwise
+}
+
+// BindRow binds names to the columns of row: the column with that name in
+// header mode, and otherwise the i-th column for the i-th name. The names
+// slice must not be modified after the first call.
+func BindRow(row *Row, names []string) RowBinding {
+	r := row.r
+	if !r.opts.header {
+		return RowBinding{row: row}
+	}
+
+	key := unsafe.SliceData(names)
+	for _, b := range r.state.columnBindings {
+		if b.names == key {
+			return RowBinding{row: row, columns: b.index}
+		}
+	}
+
+	index := make([]int, len(names))
+	for i, name := range names {
+		index[i] = r.ColumnIndex(name)
+	}
+	if len(r.state.columnBindings) < maxColumnBindings {
+		r.state.columnBindings = append(r.state.columnBindings, columnBinding{names: key, index: index})
+	}
+	return RowBinding{row: row, columns: index}
+}
+
+// Column returns the field index of the i-th bound name, or -1 if the
+// column is not in the header or the row is too short to hold it.
+func (b RowBinding) Column(i int) int {
+	c := i
+	if b.columns != nil {
+		c = b.columns[i]
+	}
+	if c >= b.row.Len() {
+		return -1
+	}
+	return c
+}
+
+// Value returns field c like Row.Field, together with the quote validation
+// error of the row so far. In ZeroCopy mode the value is copied, so it can be
+// stored.
+func (b RowBinding) Value(c int) (string, error) {
+	return b.row.fieldValue(c)
+}
+
+// Error returns the error of converting field c into the struct field named
+// field of type typ: a ParseError at the position of the field, wrapping a
+// DecodeError.
+func (b RowBinding) Error(c int, field string, typ reflect.Type, err error) error {
+	return b.row.fieldError(c, field, typ, err)
+}
+
+// columnBinding caches the column indexes of a names slice passed to BindRow.
+type columnBinding struct {
+	names *string
+	index []int
+}
@@ -0,0 +1,44 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"strings"
+	"testing"
+)
+
+// =============================================================================
+// Row Binding Tests
+// =============================================================================
+
+// TestBindRow tests column resolution and the bound on cached bindings.
+func TestBindRow(t *testing.T) {
+	r := NewReaderWithOptions(strings.NewReader("b,a\n1\n"), ReaderOptions{Header: true})
+	r.FieldsPerRecord = -1
+	row, err := r.ReadRow()
+	if err != nil {
+		t.Fatalf("ReadRow error: %v", err)
+	}
+
+	names := []string{"a", "b", "missing"}
+	b := BindRow(row, names)
+	// "a" is the second column, beyond the one-field row
+	for i, want := range []int{-1, 0, -1} {
+		if got := b.Column(i); got != want {
+			t.Errorf("Column(%d) = %d, want %d", i, got, want)
+		}
+	}
+	if v, err := b.Value(b.Column(1)); v != "1" || err != nil {
+		t.Errorf("Value = %q, %v, want \"1\"", v, err)
+	}
+
+	for range 2 * maxColumnBindings {
+		BindRow(row, []string{"a"})
+	}
+	if n := len(r.state.columnBindings); n != maxColumnBindings {
+		t.Errorf("cached %d bindings, want %d", n, maxColumnBindings)
+	}
+	if got := BindRow(row, names).Column(1); got != 0 {
+		t.Errorf("cached binding Column(1) = %d, want 0", got)
+	}
+}
@@ -0,0 +1,162 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+// =============================================================================
+// Byte-Slice Records
+// =============================================================================
+//
+// ReadBytes and ParseBytesRaw hand out fields as []byte instead of string.
+// A field that needs no unescaping or CRLF normalization is a subslice of the
+// input; only transformed fields are written to a scratch buffer.
+//
+// Every field is capped with a full slice expression, so appending to one
+// field reallocates instead of overwriting the bytes that follow it.
+//
+// =============================================================================
+
+// ReadBytes reads one record like Read, returning its fields as byte slices.
+// Errors and FieldPos behave as for Read.
+//
+// Lifetime: the returned slices alias the Reader's input buffer, or a scratch
+// buffer for fields that needed unescaping. They are valid only until the
+// next call to any read method, Reset or Release, and must not be modified.
+// Use bytes.Clone to retain a field. With ReuseRecord, the outer slice is
+// reused as well, so steady-state ReadBytes does not allocate.
+func (r *Reader) ReadBytes() ([][]byte, error) {
+	if err := r.ensureInitialized(); err != nil {
+		return nil, err
+	}
+
+	for {
+		row, _, err := r.nextRow()
+		if err != nil {
+			return nil, err
+		}
+		record, err := r.buildRecordBytes(row)
+		if err == nil {
+			err = r.finishRecord(len(record), row)
+		}
+		if err == nil {
+			return record, nil
+		}
+		if skip, err := r.skipRecord(err); !skip {
+			return record, err
+		}
+	}
+}
+
+// buildRecordBytes builds a byte-slice record, validating quotes per field.
+// On a validation error it returns the fields before the failing one.
+func (r *Reader) buildRecordBytes(row rowInfo) ([][]byte, error) {
+	fieldCount := row.fieldCount
+	fields := r.getFieldsForRow(row, fieldCount)
+	record := r.allocateBytesRecord(fieldCount)
+	r.state.fieldPositions = r.ensureFieldPositionsCapacity(fieldCount)
+
+	// Transformed fields never grow, so scratch sized to the raw row holds them all
+	r.state.recordBuffer = r.estimateAndPrepareRecordBuffer(row, fieldCount)
+
+	for i, field := range fields {
+		if err := r.validateFieldIfNeeded(field, row.lineNum); err != nil {
+			return record[:i], err
+		}
+
+		record[i] = r.fieldBytes(field)
+		r.state.fieldPositions[i] = position{line: row.lineNum, column: r.inputColumn(uint64(field.rawStart()))}
+	}
+	return record, nil
+}
+
+// fieldBytes returns the field value, writing it to recordBuffer only when a transformation is required.
+func (r *Reader) fieldBytes(field fieldInfo) []byte {
+	content, transform := r.fieldContent(field)
+	if !transform {
+		return content[:len(content):len(content)]
+	}
+	start := len(r.state.recordBuffer)
+	r.state.recordBuffer = r.appendTransformed(r.state.recordBuffer, content)
+	end := len(r.state.recordBuffer)
+	return r.state.recordBuffer[start:end:end]
+}
+
+// allocateBytesRecord returns a byte-slice record, reusing the previous one if ReuseRecord is enabled.
+func (r *Reader) allocateBytesRecord(fieldCount int) [][]byte {
+	if r.ReuseRecord && cap(r.state.lastBytesRecord) >= fieldCount {
+		r.state.lastBytesRecord = r.state.lastBytesRecord[:fieldCount]
+		return r.state.lastBytesRecord
+	}
+	record := make([][]byte, fieldCount)
+	if r.ReuseRecord {
+		r.state.lastBytesRecord = record
+	}
+	return record
+}
+
+// =============================================================================
+// Byte-Slice Records - Direct Parsing
+// =============================================================================
+
+// rawScratchSize is the minimum size of each scratch block allocated by ParseBytesRaw.
+const rawScratchSize = 4096
+
+// ParseBytesRaw is like ParseBytes but returns fields as byte slices.
+// Fields that need no unescaping alias data, so they stay valid as long as
+// data is not modified; unescaped fields are copied into buffers owned by
+// the result. Returns ErrInvalidDelim if comma is not a valid delimiter.
+func ParseBytesRaw(data []byte, comma rune) ([][][]byte, error) {
+	d, err := ParseOptions{Comma: comma}.dialect()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	sr := scanBufferSeparator(data, d.comma, d.quote)
+	pr := parseBuffer(data, sr)
+	records := buildRawRecords(data, pr, sr.hasCR, d.quote)
+
+	pr.release()
+	sr.release()
+
+	return records, nil
+}
+
+// buildRawRecords converts a parseResult to byte-slice records.
+// All records share one backing array of fields.
+func buildRawRecords(buf []byte, pr *parseResult, hasCR bool, quote byte) [][][]byte {
+	if pr == nil || len(pr.rows) == 0 {
+		return nil
+	}
+
+	records := make([][][]byte, len(pr.rows))
+	fields := make([][]byte, len(pr.fields))
+	var scratch []byte
+	for i, row := range pr.rows {
+		end := min(row.firstField+row.fieldCount, len(pr.fields))
+		record := fields[row.firstField:end:end]
+		for j, field := range pr.fields[row.firstField:end] {
+			record[j], scratch = rawFieldBytes(buf, field, hasCR, quote, scratch)
+		}
+		records[i] = record
+	}
+	return records
+}
+
+// rawFieldBytes returns the value of field within buf, or a transformed copy
+// appended to scratch. A new scratch block is started when the current one is
+// full rather than growing it, so earlier fields are never copied.
+func rawFieldBytes(buf []byte, field fieldInfo, hasCR bool, quote byte, scratch []byte) ([]byte, []byte) {
+	content := extractFieldBytes(buf, field)
+	if !field.needsUnescape() && !(hasCR && containsCRLFBytes(content)) {
+		return content[:len(content):len(content)], scratch
+	}
+
+	if cap(scratch)-len(scratch) < len(content) {
+		scratch = make([]byte, 0, max(len(content), rawScratchSize))
+	}
+	start := len(scratch)
+	scratch = transformContent(content, scratch, quote, hasCR)
+	return scratch[start:len(scratch):len(scratch)], scratch
+}
@@ -0,0 +1,596 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// simdcsvPath is the import path of the simdcsv package used by generated code.
+const simdcsvPath = "github.com/nnnkkk7/go-simdcsv"
+
+// =============================================================================
+// Package Loading
+// =============================================================================
+
+// pkgInfo holds the declarations of one package needed to resolve field types.
+type pkgInfo struct {
+	name    string
+	types   map[string]*ast.TypeSpec
+	files   map[string]*ast.File // file declaring each type
+	methods map[string][]string  // method names by receiver type
+	paths   map[*ast.File]map[string]string
+}
+
+// loadPackage parses the Go files in dir and returns the package declaring typeName.
+func loadPackage(dir, typeName string) (*pkgInfo, string, error) {
+	fset := token.NewFileSet()
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, "", err
+	}
+
+	pkgs := make(map[string]*pkgInfo)
+	var found *pkgInfo
+	var foundFile string
+	for _, path := range matches {
+		f, err := parser.ParseFile(fset, path, nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil, "", err
+		}
+		pkg := pkgs[f.Name.Name]
+		if pkg == nil {
+			pkg = &pkgInfo{
+				name:    f.Name.Name,
+				types:   make(map[string]*ast.TypeSpec),
+				files:   make(map[string]*ast.File),
+				methods: make(map[string][]string),
+				paths:   make(map[*ast.File]map[string]string),
+			}
+			pkgs[f.Name.Name] = pkg
+		}
+		pkg.addFile(f)
+		if _, ok := pkg.types[typeName]; ok && found == nil {
+			found, foundFile = pkg, path
+		}
+	}
+	if found == nil {
+		return nil, "", fmt.Errorf("type %s not found in %s", typeName, dir)
+	}
+	return found, foundFile, nil
+}
+
+// addFile records the type declarations, methods and imports of f.
+func (p *pkgInfo) addFile(f *ast.File) {
+	imports := make(map[string]string)
+	for _, imp := range f.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = path
+	}
+	p.paths[f] = imports
+
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					p.types[ts.Name.Name] = ts
+					p.files[ts.Name.Name] = f
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil || len(d.Recv.List) == 0 {
+				continue
+			}
+			recv := d.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			if id, ok := recv.(*ast.Ident); ok {
+				p.methods[id.Name] = append(p.methods[id.Name], d.Name.Name)
+			}
+		}
+	}
+}
+
+// =============================================================================
+// Field Resolution
+// =============================================================================
+
+// kind is the conversion used for a field.
+type kind int
+
+const (
+	kindString kind = iota
+	kindBool
+	kindInt
+	kindUint
+	kindFloat
+	kindTime
+	kindText
+)
+
+// basicKinds maps predeclared type names to their conversion and bit size.
+var basicKinds = map[string]struct {
+	kind kind
+	bits string
+}{
+	"string":  {kindString, ""},
+	"bool":    {kindBool, ""},
+	"int":     {kindInt, "strconv.IntSize"},
+	"int8":    {kindInt, "8"},
+	"int16":   {kindInt, "16"},
+	"int32":   {kindInt, "32"},
+	"rune":    {kindInt, "32"},
+	"int64":   {kindInt, "64"},
+	"uint":    {kindUint, "strconv.IntSize"},
+	"uint8":   {kindUint, "8"},
+	"byte":    {kindUint, "8"},
+	"uint16":  {kindUint, "16"},
+	"uint32":  {kindUint, "32"},
+	"uint64":  {kindUint, "64"},
+	"uintptr": {kindUint, "64"},
+	"float32": {kindFloat, "32"},
+	"float64": {kindFloat, "64"},
+}
+
+// field is a struct field bound to a column.
+type field struct {
+	name      string // Go field name, for errors
+	path      string // selector path from the receiver, e.g. "Base.ID"
+	column    string
+	typ       string // field type expression
+	elem      string // type converted to and from text: typ, or its element type if ptr
+	ptr       bool
+	kind      kind
+	bits      string // bit size for numeric kinds
+	omitEmpty bool
+	layout    string
+	prec      int
+}
+
+// resolver collects the fields of a struct type and the imports they need.
+type resolver struct {
+	pkg     *pkgInfo
+	layout  string
+	imports map[string]string // imports used by field types, by name
+}
+
+// structFields returns the fields of the struct type typeName, flattening
+// embedded structs like the simdcsv struct decoder does.
+func (r *resolver) structFields(typeName, prefix string) ([]field, error) {
+	ts := r.pkg.types[typeName]
+	if ts == nil {
+		return nil, fmt.Errorf("type %s not found", typeName)
+	}
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a struct type", typeName)
+	}
+	file := r.pkg.files[typeName]
+
+	var fields []field
+	for _, f := range st.Fields.List {
+		tag := ""
+		hasTag := false
+		if f.Tag != nil {
+			raw, _ := strconv.Unquote(f.Tag.Value)
+			tag, hasTag = reflect.StructTag(raw).Lookup("csv")
+		}
+		if tag == "-" {
+			continue
+		}
+
+		if len(f.Names) == 0 {
+			id, ok := f.Type.(*ast.Ident)
+			if !ok {
+				return nil, fmt.Errorf("%s: unsupported embedded field %s", typeName, types.ExprString(f.Type))
+			}
+			if emb := r.pkg.types[id.Name]; !hasTag && emb != nil {
+				if _, isStruct := emb.Type.(*ast.StructType); isStruct {
+					inner, err := r.structFields(id.Name, prefix+id.Name+".")
+					if err != nil {
+						return nil, err
+					}
+					fields = append(fields, inner...)
+					continue
+				}
+			}
+			f.Names = []*ast.Ident{id}
+		}
+
+		for _, n := range f.Names {
+			if !n.IsExported() {
+				continue
+			}
+			fd, err := r.resolveField(file, n.Name, f.Type, tag)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", typeName, n.Name, err)
+			}
+			fd.path = prefix + n.Name
+			fields = append(fields, fd)
+		}
+	}
+	return fields, nil
+}
+
+// resolveField determines how a field of type expr declared in file is converted.
+func (r *resolver) resolveField(file *ast.File, name string, expr ast.Expr, tag string) (field, error) {
+	fd := field{name: name, typ: types.ExprString(expr), prec: -1, layout: r.layout}
+	parseTag(&fd, tag)
+
+	if star, ok := expr.(*ast.StarExpr); ok {
+		fd.ptr = true
+		expr = star.X
+	}
+	fd.elem = types.ExprString(expr)
+
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if b, ok := basicKinds[e.Name]; ok {
+			fd.kind, fd.bits = b.kind, b.bits
+			return fd, nil
+		}
+		ts := r.pkg.types[e.Name]
+		if ts == nil {
+			return fd, fmt.Errorf("unknown type %s", e.Name)
+		}
+		methods := r.pkg.methods[e.Name]
+		if slices.Contains(methods, "UnmarshalText") || slices.Contains(methods, "MarshalText") {
+			fd.kind = kindText
+			return fd, nil
+		}
+		if u, ok := ts.Type.(*ast.Ident); ok && !ts.Assign.IsValid() {
+			if b, ok := basicKinds[u.Name]; ok {
+				fd.kind, fd.bits = b.kind, b.bits
+				return fd, nil
+			}
+		}
+		return fd, fmt.Errorf("unsupported type %s", e.Name)
+	case *ast.SelectorExpr:
+		pkgIdent, ok := e.X.(*ast.Ident)
+		if !ok {
+			return fd, fmt.Errorf("unsupported type %s", fd.elem)
+		}
+		path, ok := r.pkg.paths[file][pkgIdent.Name]
+		if !ok {
+			return fd, fmt.Errorf("unknown package %s", pkgIdent.Name)
+		}
+		r.imports[pkgIdent.Name] = path
+		fd.kind = kindText
+		if path == "time" && e.Sel.Name == "Time" {
+			fd.kind = kindTime
+		}
+		return fd, nil
+	}
+	return fd, fmt.Errorf("unsupported type %s", fd.elem)
+}
+
+// parseTag applies a csv struct tag to fd, matching the tag syntax of the
+// simdcsv package: a name, then omitempty, prec=N or a final layout=... option.
+func parseTag(fd *field, tag string) {
+	name, opts, _ := strings.Cut(tag, ",")
+	fd.column = name
+	if fd.column == "" {
+		fd.column = fd.name
+	}
+	for opts != "" {
+		if layout, ok := strings.CutPrefix(opts, "layout="); ok {
+			fd.layout = layout
+			break
+		}
+		var opt string
+		opt, opts, _ = strings.Cut(opts, ",")
+		if opt == "omitempty" {
+			fd.omitEmpty = true
+		} else if prec, ok := strings.CutPrefix(opt, "prec="); ok {
+			if n, err := strconv.Atoi(prec); err == nil && n >= 0 {
+				fd.prec = n
+			}
+		}
+	}
+}
+
+// =============================================================================
+// Code Generation
+// =============================================================================
+
+// generator accumulates the generated source.
+type generator struct {
+	buf  bytes.Buffer
+	uses map[string]bool // standard library packages referenced by generated code
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// generate returns the formatted bindings for the named types in dir, and
+// whether they are declared in a _test.go file.
+func generate(dir string, typeNames []string, layout string) ([]byte, bool, error) {
+	pkg, file, err := loadPackage(dir, typeNames[0])
+	if err != nil {
+		return nil, false, err
+	}
+
+	r := &resolver{pkg: pkg, layout: layout, imports: make(map[string]string)}
+	body := &generator{uses: make(map[string]bool)}
+	for _, name := range typeNames {
+		fields, err := r.structFields(name, "")
+		if err != nil {
+			return nil, false, err
+		}
+		body.genType(name, fields)
+	}
+
+	g := &generator{}
+	g.printf("// Code generated by simdcsv-gen. DO NOT EDIT.\n\n")
+	if constraint := buildConstraint(file); constraint != "" {
+		g.printf("%s\n\n", constraint)
+	}
+	g.printf("package %s\n\n", pkg.name)
+	g.printf("import (\n")
+	for _, std := range []string{"fmt", "reflect", "strconv", "time"} {
+		if body.uses[std] {
+			r.imports[std] = std
+		}
+	}
+	names := make([]string, 0, len(r.imports))
+	for name := range r.imports {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		path := r.imports[name]
+		if name == path[strings.LastIndex(path, "/")+1:] {
+			g.printf("%q\n", path)
+		} else {
+			g.printf("%s %q\n", name, path)
+		}
+	}
+	g.printf("\nsimdcsv %q\n)\n", simdcsvPath)
+	g.buf.Write(body.buf.Bytes())
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, false, fmt.Errorf("formatting generated code: %v\n%s", err, g.buf.Bytes())
+	}
+	return src, strings.HasSuffix(file, "_test.go"), nil
+}
+
+// buildConstraint returns the //go:build line of file, if any.
+func buildConstraint(path string) string {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	for line := range strings.Lines(string(src)) {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "//go:build ") {
+			return line
+		}
+		if strings.HasPrefix(line, "package ") {
+			break
+		}
+	}
+	return ""
+}
+
+// genType writes the bindings of one struct type.
+func (g *generator) genType(name string, fields []field) {
+	columns := make([]string, len(fields))
+	for i, f := range fields {
+		columns[i] = strconv.Quote(f.column)
+	}
+	columnsVar := strings.ToLower(name[:1]) + name[1:] + "CSVColumns"
+
+	g.printf("\n// %s lists the columns bound to the fields of %s.\n", columnsVar, name)
+	g.printf("var %s = []string{%s}\n", columnsVar, strings.Join(columns, ", "))
+
+	g.printf("\n// CSVHeader returns the column names of %s in field order.\n", name)
+	g.printf("func (*%s) CSVHeader() []string {\n", name)
+	g.printf("return []string{%s}\n}\n", strings.Join(columns, ", "))
+
+	g.printf("\n// DecodeCSV stores row in t, matching columns like simdcsv.Decoder.\n")
+	g.printf("func (t *%s) DecodeCSV(row *simdcsv.Row) error {\n", name)
+	g.printf("b := simdcsv.BindRow(row, %s)\n", columnsVar)
+	for i, f := range fields {
+		g.printf("if c := b.Column(%d); c >= 0 {\n", i)
+		g.printf("v, err := b.Value(c)\nif err != nil {\nreturn err\n}\n")
+		g.genDecodeField(f)
+		g.printf("}\n")
+	}
+	g.printf("return nil\n}\n")
+
+	g.printf("\n// AppendCSV writes t to w as one record, formatted like simdcsv.Encoder.\n")
+	g.printf("func (t *%s) AppendCSV(w *simdcsv.Writer) error {\n", name)
+	for _, f := range fields {
+		if f.kind == kindText {
+			g.genMarshalText(f)
+		}
+	}
+	g.printf("if err := w.BeginRecord(); err != nil {\nreturn err\n}\n")
+	for _, f := range fields {
+		g.genEncodeField(f)
+	}
+	g.printf("return w.EndRecord()\n}\n")
+}
+
+// genDecodeField writes the conversion of the column value v into field f.
+func (g *generator) genDecodeField(f field) {
+	target := "t." + f.path
+	switch {
+	case f.ptr:
+		g.printf("if v == \"\" {\n%s = nil\n} else {\np := new(%s)\n", target, f.elem)
+		g.genConvert(f, "*p", "p")
+		g.printf("%s = p\n}\n", target)
+	case f.omitEmpty:
+		g.printf("if v == \"\" {\n%s = %s\n} else {\n", target, zeroValue(f))
+		g.genConvert(f, target, target)
+		g.printf("}\n")
+	default:
+		g.genConvert(f, target, target)
+	}
+}
+
+// genConvert writes the conversion of v into target, an assignable expression;
+// method is the expression UnmarshalText is called on.
+func (g *generator) genConvert(f field, target, method string) {
+	fieldErr := fmt.Sprintf("return b.Error(c, %q, reflect.TypeFor[%s](), err)", f.name, f.typ)
+	switch f.kind {
+	case kindString:
+		if f.elem == "string" {
+			g.printf("%s = v\n", target)
+		} else {
+			g.printf("%s = %s(v)\n", target, f.elem)
+		}
+		return
+	case kindText:
+		g.uses["reflect"] = true
+		g.printf("if err := %s.UnmarshalText([]byte(v)); err != nil {\n%s\n}\n", method, fieldErr)
+		return
+	case kindBool:
+		g.uses["strconv"] = true
+		g.printf("x, err := strconv.ParseBool(v)\n")
+	case kindInt:
+		g.uses["strconv"] = true
+		g.printf("x, err := strconv.ParseInt(v, 10, %s)\n", f.bits)
+	case kindUint:
+		g.uses["strconv"] = true
+		g.printf("x, err := strconv.ParseUint(v, 10, %s)\n", f.bits)
+	case kindFloat:
+		g.uses["strconv"] = true
+		g.printf("x, err := strconv.ParseFloat(v, %s)\n", f.bits)
+	case kindTime:
+		g.uses["time"] = true
+		g.printf("x, err := time.Parse(%q, v)\n", f.layout)
+	}
+	g.uses["reflect"] = true
+	g.printf("if err != nil {\n%s\n}\n", fieldErr)
+	if f.elem == parseResultTypes[f.kind] {
+		g.printf("%s = x\n", target)
+	} else {
+		g.printf("%s = %s(x)\n", target, f.elem)
+	}
+}
+
+// parseResultTypes are the types of the values parsed for each kind.
+var parseResultTypes = map[kind]string{
+	kindBool:  "bool",
+	kindInt:   "int64",
+	kindUint:  "uint64",
+	kindFloat: "float64",
+	kindTime:  "time.Time",
+}
+
+// zeroValue returns an expression for the zero value of the element type of f.
+func zeroValue(f field) string {
+	switch f.kind {
+	case kindString:
+		return `""`
+	case kindBool:
+		return "false"
+	case kindTime:
+		return "time.Time{}"
+	case kindText:
+		return "*new(" + f.elem + ")"
+	}
+	return "0"
+}
+
+// parenLiteral parenthesizes a composite literal so it can be used in an if condition.
+func parenLiteral(expr string) string {
+	if strings.HasSuffix(expr, "}") {
+		return "(" + expr + ")"
+	}
+	return expr
+}
+
+// textVar returns the name of the variable holding the marshaled text of f.
+// It is built from the selector path, so equally named fields of different
+// embedded structs get distinct variables.
+func textVar(f field) string {
+	return strings.ToLower(f.path[:1]) + strings.ReplaceAll(f.path[1:], ".", "_") + "Text"
+}
+
+// genMarshalText marshals a text field before the record is started, so a
+// marshaling error leaves no partial record.
+func (g *generator) genMarshalText(f field) {
+	g.uses["fmt"] = true
+	v := textVar(f)
+	target := "t." + f.path
+	errReturn := fmt.Sprintf("return fmt.Errorf(\"simdcsv: encoding field %s: %%w\", err)", f.name)
+
+	var cond string
+	switch {
+	case f.ptr:
+		cond = target + " != nil"
+	case f.omitEmpty:
+		cond = target + " != " + parenLiteral(zeroValue(f))
+	default:
+		g.printf("%s, err := %s.MarshalText()\nif err != nil {\n%s\n}\n", v, target, errReturn)
+		return
+	}
+	g.printf("var %s []byte\nif %s {\n", v, cond)
+	g.printf("b, err := %s.MarshalText()\nif err != nil {\n%s\n}\n", target, errReturn)
+	g.printf("%s = b\n}\n", v)
+}
+
+// genEncodeField writes field f to w.
+func (g *generator) genEncodeField(f field) {
+	if f.kind == kindText {
+		g.printf("w.WriteFieldBytes(%s)\n", textVar(f))
+		return
+	}
+
+	target := "t." + f.path
+	value := target
+	nested := true
+	switch {
+	case f.ptr:
+		g.printf("if %s == nil {\nw.WriteField(\"\")\n} else {\n", target)
+		value = "*" + target
+	case f.omitEmpty:
+		g.printf("if %s == %s {\nw.WriteField(\"\")\n} else {\n", target, parenLiteral(zeroValue(f)))
+	default:
+		nested = false
+	}
+
+	conv := func(typ string) string {
+		if typ != f.elem {
+			return typ + "(" + value + ")"
+		}
+		return value
+	}
+	switch f.kind {
+	case kindString:
+		g.printf("w.WriteField(%s)\n", conv("string"))
+	case kindBool:
+		g.printf("w.WriteBool(%s)\n", conv("bool"))
+	case kindInt:
+		g.printf("w.WriteInt(%s)\n", conv("int64"))
+	case kindUint:
+		g.printf("w.WriteUint(%s)\n", conv("uint64"))
+	case kindFloat:
+		g.printf("w.WriteFloat(%s, %d, %s)\n", conv("float64"), f.prec, f.bits)
+	case kindTime:
+		g.printf("w.WriteTime(%s, %q)\n", value, f.layout)
+	}
+	if nested {
+		g.printf("}\n")
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestGenerate_UpToDate verifies the checked-in bindings used by the simdcsv
+// tests match the generator's current output.
+func TestGenerate_UpToDate(t *testing.T) {
+	got, testFile, err := generate("../..", []string{"bindRecord", "bindPoint"}, time.RFC3339)
+	if err != nil {
+		t.Fatalf("generate error: %v", err)
+	}
+	if !testFile {
+		t.Error("generate reported bindRecord as declared outside a _test.go file")
+	}
+	want, err := os.ReadFile("../../bind_gen_test.go")
+	if err != nil {
+		t.Fatalf("reading generated file: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("bind_gen_test.go is stale; run go generate in the repository root")
+	}
+}
+
+// TestGenerate_EmbeddedText tests text fields of the same name flattened from
+// two embedded structs.
+func TestGenerate_EmbeddedText(t *testing.T) {
+	src := `package p
+
+type Code string
+
+func (c Code) MarshalText() ([]byte, error) { return []byte(c), nil }
+
+func (c *Code) UnmarshalText(b []byte) error { *c = Code(b); return nil }
+
+type A struct {
+	Code Code ` + "`csv:\"a_code\"`" + `
+}
+
+type B struct {
+	Code Code ` + "`csv:\"b_code\"`" + `
+}
+
+type T struct {
+	A
+	B
+}
+`
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "t.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out, _, err := generate(dir, []string{"T"}, time.RFC3339)
+	if err != nil {
+		t.Fatalf("generate error: %v", err)
+	}
+
+	// Type-check the output with the input; the simdcsv import cannot be
+	// resolved here, but redeclared variables are still reported
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for name, data := range map[string][]byte{"t.go": []byte(src), "gen.go": out} {
+		f, err := parser.ParseFile(fset, name, data, 0)
+		if err != nil {
+			t.Fatalf("parsing %s: %v", name, err)
+		}
+		files = append(files, f)
+	}
+	var errs []string
+	conf := types.Config{Importer: importer.Default(), Error: func(err error) {
+		if msg := err.Error(); strings.Contains(msg, "redeclared") || strings.Contains(msg, "no new variables") {
+			errs = append(errs, msg)
+		}
+	}}
+	_, _ = conf.Check("p", fset, files, nil)
+	if len(errs) > 0 {
+		t.Errorf("generated code declares a variable twice:\n%s", strings.Join(errs, "\n"))
+	}
+}
+
+// TestGenerate_Errors tests types the generator cannot bind.
+func TestGenerate_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		typ  string
+		want string
+	}{
+		{"missing", "type T struct{}", "U", "type U not found"},
+		{"not struct", "type T int", "T", "not a struct type"},
+		{"slice field", "type T struct{ A []int }", "T", "T.A: unsupported type []int"},
+		{"unknown type", "type T struct{ A Other }", "T", "T.A: unknown type Other"},
+		{"struct field", "type S struct{}\ntype T struct{ A S }", "T", "T.A: unsupported type S"},
+		{"embedded pointer", "type S struct{}\ntype T struct{ *S }", "T", "unsupported embedded field *S"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "t.go"), []byte("package p\n\n"+tt.src+"\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, _, err := generate(dir, []string{tt.typ}, time.RFC3339)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("generate error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
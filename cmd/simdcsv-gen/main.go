@@ -0,0 +1,76 @@
+// Command simdcsv-gen generates reflection-free CSV bindings for struct types.
+//
+// For each named struct type T it writes three methods:
+//
+//	func (*T) CSVHeader() []string
+//	func (t *T) DecodeCSV(row *simdcsv.Row) error
+//	func (t *T) AppendCSV(w *simdcsv.Writer) error
+//
+// DecodeCSV and AppendCSV behave like simdcsv.Decoder.Decode and
+// simdcsv.Encoder.Encode for the same csv struct tags, but convert each field
+// with straight-line code instead of reflection: DecodeCSV reads columns from
+// a lazy Row returned by Reader.ReadRow, and AppendCSV formats numbers
+// directly into the Writer's buffer.
+//
+// Typical use is a go:generate directive next to the type:
+//
+//	//go:generate go run github.com/nnnkkk7/go-simdcsv/cmd/simdcsv-gen -type=Trade
+//
+// Field types are resolved syntactically. Built-in string, bool, integer and
+// float types, time.Time, and local types defined on them are converted
+// directly; local types with MarshalText or UnmarshalText methods and all
+// other imported types are treated as encoding.TextMarshaler and
+// encoding.TextUnmarshaler. Any of these may be used through a pointer.
+//
+// Usage:
+//
+//	simdcsv-gen -type=T[,U...] [-output file] [-timelayout layout] [dir]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func main() {
+	types := flag.String("type", "", "comma-separated list of struct type names; required")
+	output := flag.String("output", "", "output file name; default <dir>/<type>_csv.go")
+	layout := flag.String("timelayout", time.RFC3339, "layout for time.Time fields without a layout tag option")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: simdcsv-gen -type=T[,U...] [-output file] [-timelayout layout] [dir]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *types == "" || flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+
+	names := strings.Split(*types, ",")
+	src, testFile, err := generate(dir, names, *layout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "simdcsv-gen: %v\n", err)
+		os.Exit(1)
+	}
+
+	file := *output
+	if file == "" {
+		file = strings.ToLower(names[0]) + "_csv.go"
+		if testFile {
+			file = strings.ToLower(names[0]) + "_csv_test.go"
+		}
+		file = filepath.Join(dir, file)
+	}
+	if err := os.WriteFile(file, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "simdcsv-gen: %v\n", err)
+		os.Exit(1)
+	}
+}
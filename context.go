@@ -0,0 +1,67 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import "context"
+
+// =============================================================================
+// Context-Aware Reading
+// =============================================================================
+//
+// ReadContext and ReadAllContext install the context for the duration of the
+// call. It is checked before each record, before each window is scanned and
+// parsed, and before each read from the source. A window is scanned and parsed
+// as a unit, so cancellation latency is bounded by ReaderOptions.BufferSize.
+//
+// Cancellation is not sticky: bytes already read from the source are kept as
+// the pending tail, so a later call continues exactly where the cancelled one
+// stopped.
+//
+// =============================================================================
+
+// ReadContext is like Read but returns ctx.Err() once ctx is done.
+// A call blocked inside the underlying io.Reader is not interrupted; close
+// the source to unblock it. Records not yet returned remain available to
+// subsequent reads.
+func (r *Reader) ReadContext(ctx context.Context) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.setContext(ctx)
+	defer r.setContext(nil)
+	return r.Read()
+}
+
+// ReadAllContext is like ReadAll but stops once ctx is done, returning the
+// records read so far together with ctx.Err(). The remaining records can be
+// read by a later call.
+func (r *Reader) ReadAllContext(ctx context.Context) ([][]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.setContext(ctx)
+	defer r.setContext(nil)
+	return r.ReadAll()
+}
+
+// setContext installs ctx for cancellation checks, or removes it if ctx is nil.
+func (r *Reader) setContext(ctx context.Context) {
+	r.state.ctx = ctx
+	r.state.ctxDone = nil
+	if ctx != nil {
+		r.state.ctxDone = ctx.Done()
+	}
+}
+
+// ctxErr returns the error of the installed context once it is done, or nil.
+func (r *Reader) ctxErr() error {
+	if r.state.ctxDone == nil {
+		return nil
+	}
+	select {
+	case <-r.state.ctxDone:
+		return r.state.ctx.Err()
+	default:
+		return nil
+	}
+}
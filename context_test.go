@@ -0,0 +1,90 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// =============================================================================
+// Context Tests
+// =============================================================================
+
+// cancelAfterReader cancels a context once n reads have been served.
+type cancelAfterReader struct {
+	r      io.Reader
+	n      int
+	cancel context.CancelFunc
+}
+
+func (c *cancelAfterReader) Read(p []byte) (int, error) {
+	if c.n--; c.n == 0 {
+		c.cancel()
+	}
+	return c.r.Read(p)
+}
+
+// TestReadAllContext_Canceled verifies ReadAllContext stops mid-input and a
+// later ReadAll returns exactly the remaining records.
+func TestReadAllContext_Canceled(t *testing.T) {
+	input := generateWindowedCSV(8 * 4096)
+	want := readAllStdlib(t, input)
+
+	for _, opts := range []ReaderOptions{{BufferSize: 4096}, {BufferSize: 4096, ZeroCopy: true}, {BufferSize: 8192, ChunkSize: 1024}} {
+		t.Run(fmt.Sprintf("%+v", opts), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			src := &cancelAfterReader{r: iotest.HalfReader(strings.NewReader(input)), n: 5, cancel: cancel}
+
+			r := NewReaderWithOptions(src, opts)
+			r.FieldsPerRecord = -1
+			head, err := r.ReadAllContext(ctx)
+			if !errors.Is(err, context.Canceled) {
+				t.Fatalf("ReadAllContext error: got %v, want context.Canceled", err)
+			}
+			if len(head) >= len(want) {
+				t.Fatalf("ReadAllContext returned all %d records despite cancellation", len(head))
+			}
+
+			tail, err := r.ReadAll()
+			if err != nil {
+				t.Fatalf("ReadAll after cancellation error: %v", err)
+			}
+			if got := append(head, tail...); !reflect.DeepEqual(got, want) {
+				t.Errorf("records across cancellation: got %d, want %d", len(got), len(want))
+			}
+		})
+	}
+}
+
+// TestReadContext tests cancellation between records.
+func TestReadContext(t *testing.T) {
+	r := NewReader(strings.NewReader("a,b\nc,d\n"))
+	ctx, cancel := context.WithCancel(context.Background())
+
+	record, err := r.ReadContext(ctx)
+	if err != nil || !reflect.DeepEqual(record, []string{"a", "b"}) {
+		t.Fatalf("ReadContext = %q, %v; want [a b], nil", record, err)
+	}
+
+	cancel()
+	if _, err := r.ReadContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("ReadContext after cancel: got %v, want context.Canceled", err)
+	}
+	if _, err := r.ReadAllContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("ReadAllContext after cancel: got %v, want context.Canceled", err)
+	}
+
+	// The context is only installed for the duration of the call
+	record, err = r.Read()
+	if err != nil || !reflect.DeepEqual(record, []string{"c", "d"}) {
+		t.Fatalf("Read = %q, %v; want [c d], nil", record, err)
+	}
+}
@@ -0,0 +1,351 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// Struct Decoding
+// =============================================================================
+//
+// A Decoder maps the columns of each record onto the exported fields of a
+// struct. The field layout of a struct type is resolved once per type and
+// header into a decode plan; each Decode then reads a lazy Row and converts
+// only the columns the struct uses.
+//
+// Struct tags follow encoding/json:
+//
+//	Name  string    `csv:"name"`                  // column "name"
+//	Email *string   `csv:"email,omitempty"`       // nullable column
+//	Born  time.Time `csv:"born,layout=2006-01-02"` // custom time layout
+//	Score float64   `csv:"score,prec=2"`          // 2 decimals when encoding
+//	Notes string    `csv:"-"`                     // ignored
+//
+// Untagged exported fields use the field name. Fields of embedded structs are
+// promoted as if they were declared in the outer struct.
+//
+// =============================================================================
+
+// Decoder reads records from a Reader and stores them in structs.
+//
+// In header mode (ReaderOptions.Header), fields are matched to columns by
+// name using Reader.ColumnIndex; fields without a matching column are left
+// unchanged. Otherwise the n-th field maps to the n-th column.
+//
+// Supported field types are string, bool, the integer and floating-point
+// kinds, time.Time, types implementing encoding.TextUnmarshaler, and pointers
+// to any of these. An empty column sets a pointer field to nil, and sets a
+// field tagged omitempty to its zero value; for other fields it is converted
+// like any other value, so an empty int column is an error.
+type Decoder struct {
+	// TimeLayout is the layout for time.Time fields without a layout tag
+	// option (default: time.RFC3339). It must be set before the first Decode.
+	TimeLayout string
+
+	r     *Reader
+	plans map[reflect.Type]*decodePlan
+}
+
+// DecodeError describes a column that could not be converted to the type of
+// its struct field. Decode returns it wrapped in a ParseError that locates the
+// column in the input, as reported by FieldPos.
+type DecodeError struct {
+	Field string       // name of the struct field
+	Type  reflect.Type // type of the struct field
+	Value string       // column value
+	Err   error        // conversion error
+}
+
+// Error returns a message naming the field, its type and the offending value.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("cannot decode %q into field %s of type %s: %v", e.Value, e.Field, e.Type, e.Err)
+}
+
+// Unwrap returns the underlying conversion error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// NewDecoder returns a Decoder that reads records from r.
+func NewDecoder(r *Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// Decode reads the next record and stores it in the struct pointed to by v.
+// At the end of the input Decode returns io.EOF. Read errors are returned as
+// from Read; a record with the wrong number of fields is not decoded.
+func (d *Decoder) Decode(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("simdcsv: Decode requires a non-nil pointer to a struct, got %T", v)
+	}
+
+	row, err := d.r.ReadRow()
+	if err != nil {
+		return err
+	}
+	plan, err := d.planFor(rv.Elem().Type())
+	if err != nil {
+		return err
+	}
+
+	dst := rv.Elem()
+	for _, f := range plan.fields {
+		if f.column >= row.Len() {
+			continue
+		}
+		s, err := row.fieldValue(f.column)
+		if err != nil {
+			return err
+		}
+		if err := f.set(dst.FieldByIndex(f.index), s); err != nil {
+			return row.fieldError(f.column, f.name, f.typ, err)
+		}
+	}
+	return nil
+}
+
+// =============================================================================
+// Decode Plans
+// =============================================================================
+
+// decodePlan lists the columns decoded into a struct type.
+type decodePlan struct {
+	header []string // header the plan was resolved against, nil without header mode
+	fields []decodeField
+}
+
+// decodeField binds one column to a struct field.
+type decodeField struct {
+	name   string // struct field name, for errors
+	typ    reflect.Type
+	index  []int
+	column int
+	set    func(v reflect.Value, s string) error
+}
+
+// planFor returns the decode plan for t, resolving it against the current header.
+func (d *Decoder) planFor(t reflect.Type) (*decodePlan, error) {
+	header := d.r.Header()
+	if plan, ok := d.plans[t]; ok && sameHeader(plan.header, header) {
+		return plan, nil
+	}
+
+	layout := d.TimeLayout
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	plan := &decodePlan{header: header}
+	for n, sf := range structFields(t) {
+		column := n
+		if d.r.opts.header {
+			if column = d.r.ColumnIndex(sf.tag.name); column < 0 {
+				continue
+			}
+		}
+		set, ok := decodeFunc(sf.field.Type, sf.tag, layout)
+		if !ok {
+			return nil, fmt.Errorf("simdcsv: cannot decode into field %s of type %s", sf.field.Name, sf.field.Type)
+		}
+		plan.fields = append(plan.fields, decodeField{
+			name:   sf.field.Name,
+			typ:    sf.field.Type,
+			index:  sf.index,
+			column: column,
+			set:    set,
+		})
+	}
+
+	if d.plans == nil {
+		d.plans = make(map[reflect.Type]*decodePlan)
+	}
+	d.plans[t] = plan
+	return plan, nil
+}
+
+// sameHeader reports whether a and b are the same header slice.
+func sameHeader(a, b []string) bool {
+	return len(a) == len(b) && (len(a) == 0 || &a[0] == &b[0])
+}
+
+var (
+	timeType            = reflect.TypeFor[time.Time]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// decodeFunc returns the conversion from a column value to a field of type t,
+// or false if t is not supported.
+func decodeFunc(t reflect.Type, tag fieldTag, layout string) (func(reflect.Value, string) error, bool) {
+	if tag.layout != "" {
+		layout = tag.layout
+	}
+
+	var set func(reflect.Value, string) error
+	switch {
+	case t.Kind() == reflect.Pointer:
+		elem, ok := decodeFunc(t.Elem(), tag, layout)
+		if !ok {
+			return nil, false
+		}
+		return func(v reflect.Value, s string) error {
+			if s == "" {
+				v.SetZero()
+				return nil
+			}
+			// A fresh value keeps copies of earlier results independent
+			p := reflect.New(t.Elem())
+			if err := elem(p.Elem(), s); err != nil {
+				return err
+			}
+			v.Set(p)
+			return nil
+		}, true
+	case t == timeType:
+		set = func(v reflect.Value, s string) error {
+			tm, err := time.Parse(layout, s)
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(tm))
+			return nil
+		}
+	case reflect.PointerTo(t).Implements(textUnmarshalerType):
+		set = func(v reflect.Value, s string) error {
+			return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+		}
+	case t.Kind() == reflect.String:
+		set = func(v reflect.Value, s string) error {
+			v.SetString(s)
+			return nil
+		}
+	case t.Kind() == reflect.Bool:
+		set = func(v reflect.Value, s string) error {
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return err
+			}
+			v.SetBool(b)
+			return nil
+		}
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64:
+		bits := t.Bits()
+		set = func(v reflect.Value, s string) error {
+			n, err := strconv.ParseInt(s, 10, bits)
+			if err != nil {
+				return err
+			}
+			v.SetInt(n)
+			return nil
+		}
+	case t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uintptr:
+		bits := t.Bits()
+		set = func(v reflect.Value, s string) error {
+			n, err := strconv.ParseUint(s, 10, bits)
+			if err != nil {
+				return err
+			}
+			v.SetUint(n)
+			return nil
+		}
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		bits := t.Bits()
+		set = func(v reflect.Value, s string) error {
+			f, err := strconv.ParseFloat(s, bits)
+			if err != nil {
+				return err
+			}
+			v.SetFloat(f)
+			return nil
+		}
+	default:
+		return nil, false
+	}
+
+	if !tag.omitEmpty {
+		return set, true
+	}
+	return func(v reflect.Value, s string) error {
+		if s == "" {
+			v.SetZero()
+			return nil
+		}
+		return set(v, s)
+	}, true
+}
+
+// =============================================================================
+// Struct Tags
+// =============================================================================
+
+// fieldTag holds the parsed csv struct tag of a field.
+type fieldTag struct {
+	name      string
+	omitEmpty bool
+	layout    string // time.Time layout, from layout=...
+	prec      int    // float precision for encoding, from prec=...; -1 if unset
+}
+
+// structField is an exported field reachable from a struct type.
+type structField struct {
+	field reflect.StructField
+	index []int
+	tag   fieldTag
+}
+
+// structFields returns the fields of t mapped to columns, in declaration
+// order, with embedded structs flattened.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup("csv")
+		if tag == "-" {
+			continue
+		}
+		if f.Anonymous && !ok && f.Type.Kind() == reflect.Struct {
+			for _, inner := range structFields(f.Type) {
+				inner.index = append([]int{i}, inner.index...)
+				fields = append(fields, inner)
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		fields = append(fields, structField{field: f, index: []int{i}, tag: parseFieldTag(f.Name, tag)})
+	}
+	return fields
+}
+
+// parseFieldTag parses a csv struct tag; an empty name defaults to the field name.
+// The layout option takes the rest of the tag, so layouts may contain commas.
+func parseFieldTag(fieldName, tag string) fieldTag {
+	name, opts, _ := strings.Cut(tag, ",")
+	ft := fieldTag{name: name, prec: -1}
+	if ft.name == "" {
+		ft.name = fieldName
+	}
+	for opts != "" {
+		if layout, ok := strings.CutPrefix(opts, "layout="); ok {
+			ft.layout = layout
+			break
+		}
+		var opt string
+		opt, opts, _ = strings.Cut(opts, ",")
+		if opt == "omitempty" {
+			ft.omitEmpty = true
+		} else if prec, ok := strings.CutPrefix(opt, "prec="); ok {
+			if n, err := strconv.Atoi(prec); err == nil && n >= 0 {
+				ft.prec = n
+			}
+		}
+	}
+	return ft
+}
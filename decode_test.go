@@ -0,0 +1,171 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"errors"
+	"io"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// =============================================================================
+// Struct Decoding Tests
+// =============================================================================
+
+type decodeBase struct {
+	ID int64 `csv:"id"`
+}
+
+type decodeRecord struct {
+	decodeBase
+	Name    string     `csv:"name"`
+	Score   float64    `csv:"score,omitempty"`
+	Active  bool       `csv:"active"`
+	Age     *uint8     `csv:"age"`
+	Addr    netip.Addr `csv:"addr"`
+	Born    time.Time  `csv:"born,layout=2006-01-02"`
+	Seen    *time.Time `csv:"seen"`
+	Ignored string     `csv:"-"`
+	Extra   string
+	private string
+}
+
+// TestDecoder_Header tests decoding by column name in header mode.
+func TestDecoder_Header(t *testing.T) {
+	input := "seen,Extra,name,id,score,active,age,addr,born,unused\n" +
+		"2024-05-01T10:00:00Z,x,\"Smith, \"\"J\"\"\",7,1.5,true,42,10.0.0.1,1990-02-03,u\n" +
+		",,bob,8,,false,,::1,2000-01-01,\n"
+	for _, opts := range []ReaderOptions{{Header: true}, {Header: true, ZeroCopy: true, BufferSize: 4096}} {
+		r := NewReaderWithOptions(strings.NewReader(input), opts)
+		dec := NewDecoder(r)
+
+		var got []decodeRecord
+		for {
+			var rec decodeRecord
+			err := dec.Decode(&rec)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Decode error: %v", err)
+			}
+			got = append(got, rec)
+		}
+
+		age := uint8(42)
+		seen := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+		want := []decodeRecord{
+			{
+				decodeBase: decodeBase{ID: 7}, Name: `Smith, "J"`, Score: 1.5, Active: true, Age: &age,
+				Addr: netip.MustParseAddr("10.0.0.1"), Born: time.Date(1990, 2, 3, 0, 0, 0, 0, time.UTC),
+				Seen: &seen, Extra: "x",
+			},
+			{
+				decodeBase: decodeBase{ID: 8}, Name: "bob", Addr: netip.MustParseAddr("::1"),
+				Born: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%+v: Decode records = %+v, want %+v", opts, got, want)
+		}
+	}
+}
+
+// TestDecoder_Positional tests decoding by column index without header mode.
+func TestDecoder_Positional(t *testing.T) {
+	type point struct {
+		X, Y  int
+		Label string `csv:",omitempty"`
+	}
+	r := NewReader(strings.NewReader("1,2,a\n3,4\n"))
+	r.FieldsPerRecord = -1
+	dec := NewDecoder(r)
+
+	var got []point
+	for {
+		var p point
+		if err := dec.Decode(&p); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Decode error: %v", err)
+		}
+		got = append(got, p)
+	}
+	if want := []point{{1, 2, "a"}, {3, 4, ""}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Decode records = %+v, want %+v", got, want)
+	}
+}
+
+// TestDecoder_TimeLayout tests the Decoder-wide time layout.
+func TestDecoder_TimeLayout(t *testing.T) {
+	var rec struct{ At time.Time }
+	dec := NewDecoder(NewReader(strings.NewReader("02 Jan 06 15:04 UTC\n")))
+	dec.TimeLayout = time.RFC822
+	if err := dec.Decode(&rec); err != nil {
+		t.Fatalf("Decode error: %v", err)
+	}
+	if want := time.Date(2006, 1, 2, 15, 4, 0, 0, time.UTC); !rec.At.Equal(want) {
+		t.Errorf("At = %v, want %v", rec.At, want)
+	}
+}
+
+// TestDecoder_Errors tests conversion error positions and invalid targets.
+func TestDecoder_Errors(t *testing.T) {
+	type target struct {
+		Name string `csv:"name"`
+		N    int8   `csv:"n"`
+	}
+	r := NewReaderWithOptions(strings.NewReader("name,n\nok,1\nlong,300\n\"bad\"x,1\n"), ReaderOptions{Header: true})
+	dec := NewDecoder(r)
+
+	var rec target
+	if err := dec.Decode(&rec); err != nil {
+		t.Fatalf("Decode error: %v", err)
+	}
+
+	err := dec.Decode(&rec)
+	var pe *ParseError
+	var de *DecodeError
+	if !errors.As(err, &pe) || !errors.As(err, &de) {
+		t.Fatalf("Decode error = %v, want ParseError wrapping DecodeError", err)
+	}
+	if line, column := r.FieldPos(1); pe.Line != 3 || pe.Line != line || pe.Column != column {
+		t.Errorf("Decode error position = %d:%d, want FieldPos(1) = %d:%d on line 3", pe.Line, pe.Column, line, column)
+	}
+	if de.Field != "N" || de.Value != "300" || !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("Decode error = %v, want out-of-range value 300 for field N", err)
+	}
+
+	if err := dec.Decode(&rec); !errors.Is(err, ErrQuote) {
+		t.Errorf("Decode of malformed field: got %v, want ErrQuote", err)
+	}
+
+	var unsupported struct{ C chan int }
+	if err := NewDecoder(NewReader(strings.NewReader("x\n"))).Decode(&unsupported); err == nil {
+		t.Error("Decode into chan field: got nil error")
+	}
+	if err := dec.Decode(rec); err == nil {
+		t.Error("Decode into non-pointer: got nil error")
+	}
+}
+
+// TestDecoder_PointerIndependence verifies pointer fields of earlier results are not overwritten.
+func TestDecoder_PointerIndependence(t *testing.T) {
+	var rec struct{ N *int }
+	dec := NewDecoder(NewReader(strings.NewReader("1\n2\n")))
+	if err := dec.Decode(&rec); err != nil {
+		t.Fatalf("Decode error: %v", err)
+	}
+	first := rec
+	if err := dec.Decode(&rec); err != nil {
+		t.Fatalf("Decode error: %v", err)
+	}
+	if *first.N != 1 || *rec.N != 2 {
+		t.Errorf("N values = %d, %d; want 1, 2", *first.N, *rec.N)
+	}
+}
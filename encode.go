@@ -0,0 +1,258 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Struct Encoding
+// =============================================================================
+//
+// An Encoder writes structs through a Writer using the same csv struct tags
+// as Decoder. Each field value is formatted into a scratch buffer with the
+// strconv.Append functions and then written with the Writer's own quoting, so
+// the output is exactly what Write would produce for the formatted strings.
+//
+// =============================================================================
+
+// Encoder writes structs as CSV records to a Writer.
+//
+// Before the first record, Encode writes a header of the column names of the
+// struct's fields, unless OmitHeader is set. Field types are those supported
+// by Decoder, with encoding.TextMarshaler in place of TextUnmarshaler. A nil
+// pointer is written as an empty field, as is a zero value tagged omitempty.
+// Floats are written in the shortest decimal form that parses back to the
+// same value, or with the precision given by the prec tag option.
+//
+// Output is buffered by the Writer; call Writer.Flush when done.
+type Encoder struct {
+	// TimeLayout is the layout for time.Time fields without a layout tag
+	// option (default: time.RFC3339). It must be set before the first Encode.
+	TimeLayout string
+
+	// OmitHeader disables writing the header before the first record.
+	OmitHeader bool
+
+	w           *Writer
+	plans       map[reflect.Type]*encodePlan
+	wroteHeader bool
+	buf         []byte // formatted field values of the current record
+	ends        []int  // end offset of each field in buf
+}
+
+// NewEncoder returns an Encoder that writes to w.
+func NewEncoder(w *Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes v, a struct, a pointer to a struct, or a slice or array of
+// either, as one record per struct. The header is taken from the struct type
+// of the first call.
+func (e *Encoder) Encode(v any) error {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		t := rv.Type().Elem()
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			break
+		}
+		plan, err := e.planFor(t)
+		if err != nil {
+			return err
+		}
+		for i := range rv.Len() {
+			if err := e.encodeValue(rv.Index(i), plan); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Pointer, reflect.Struct:
+		t := rv.Type()
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			break
+		}
+		plan, err := e.planFor(t)
+		if err != nil {
+			return err
+		}
+		return e.encodeValue(rv, plan)
+	}
+	return fmt.Errorf("simdcsv: Encode requires a struct, a pointer to a struct or a slice of them, got %T", v)
+}
+
+// encodeValue writes one struct, dereferencing a pointer.
+func (e *Encoder) encodeValue(v reflect.Value, plan *encodePlan) error {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return fmt.Errorf("simdcsv: Encode of nil *%s", v.Type().Elem())
+		}
+		v = v.Elem()
+	}
+	if !v.CanAddr() {
+		// Pointer-receiver TextMarshalers need an addressable value
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		v = c
+	}
+
+	// Format every field first so a marshaling error leaves no partial record
+	e.buf = e.buf[:0]
+	e.ends = e.ends[:0]
+	for _, f := range plan.fields {
+		var err error
+		if e.buf, err = f.appendValue(e.buf, v.FieldByIndex(f.index)); err != nil {
+			return fmt.Errorf("simdcsv: encoding field %s: %w", f.name, err)
+		}
+		e.ends = append(e.ends, len(e.buf))
+	}
+
+	if err := e.writeHeader(plan); err != nil {
+		return err
+	}
+	if err := e.w.BeginRecord(); err != nil {
+		return err
+	}
+	start := 0
+	for _, end := range e.ends {
+		e.w.WriteFieldBytes(e.buf[start:end])
+		start = end
+	}
+	return e.w.EndRecord()
+}
+
+// writeHeader writes the column names of plan before the first record.
+func (e *Encoder) writeHeader(plan *encodePlan) error {
+	if e.wroteHeader || e.OmitHeader {
+		return nil
+	}
+	e.wroteHeader = true
+	return e.w.Write(plan.header)
+}
+
+// =============================================================================
+// Encode Plans
+// =============================================================================
+
+// encodePlan lists the fields encoded from a struct type.
+type encodePlan struct {
+	header []string
+	fields []encodeField
+}
+
+// encodeField formats one struct field.
+type encodeField struct {
+	name        string // struct field name, for errors
+	index       []int
+	appendValue func(dst []byte, v reflect.Value) ([]byte, error)
+}
+
+// planFor returns the encode plan for t.
+func (e *Encoder) planFor(t reflect.Type) (*encodePlan, error) {
+	if plan, ok := e.plans[t]; ok {
+		return plan, nil
+	}
+
+	layout := e.TimeLayout
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	plan := &encodePlan{}
+	for _, sf := range structFields(t) {
+		appendValue, ok := encodeFunc(sf.field.Type, sf.tag, layout)
+		if !ok {
+			return nil, fmt.Errorf("simdcsv: cannot encode field %s of type %s", sf.field.Name, sf.field.Type)
+		}
+		plan.header = append(plan.header, sf.tag.name)
+		plan.fields = append(plan.fields, encodeField{name: sf.field.Name, index: sf.index, appendValue: appendValue})
+	}
+
+	if e.plans == nil {
+		e.plans = make(map[reflect.Type]*encodePlan)
+	}
+	e.plans[t] = plan
+	return plan, nil
+}
+
+var textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+
+// encodeFunc returns the formatter for a field of type t, or false if t is not supported.
+func encodeFunc(t reflect.Type, tag fieldTag, layout string) (func([]byte, reflect.Value) ([]byte, error), bool) {
+	if tag.layout != "" {
+		layout = tag.layout
+	}
+
+	var appendValue func([]byte, reflect.Value) ([]byte, error)
+	switch {
+	case t.Kind() == reflect.Pointer:
+		elem, ok := encodeFunc(t.Elem(), tag, layout)
+		if !ok {
+			return nil, false
+		}
+		return func(dst []byte, v reflect.Value) ([]byte, error) {
+			if v.IsNil() {
+				return dst, nil
+			}
+			return elem(dst, v.Elem())
+		}, true
+	case t == timeType:
+		appendValue = func(dst []byte, v reflect.Value) ([]byte, error) {
+			return v.Interface().(time.Time).AppendFormat(dst, layout), nil
+		}
+	case t.Implements(textMarshalerType):
+		appendValue = func(dst []byte, v reflect.Value) ([]byte, error) {
+			text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+			return append(dst, text...), err
+		}
+	case reflect.PointerTo(t).Implements(textMarshalerType):
+		appendValue = func(dst []byte, v reflect.Value) ([]byte, error) {
+			text, err := v.Addr().Interface().(encoding.TextMarshaler).MarshalText()
+			return append(dst, text...), err
+		}
+	case t.Kind() == reflect.String:
+		appendValue = func(dst []byte, v reflect.Value) ([]byte, error) {
+			return append(dst, v.String()...), nil
+		}
+	case t.Kind() == reflect.Bool:
+		appendValue = func(dst []byte, v reflect.Value) ([]byte, error) {
+			return strconv.AppendBool(dst, v.Bool()), nil
+		}
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64:
+		appendValue = func(dst []byte, v reflect.Value) ([]byte, error) {
+			return strconv.AppendInt(dst, v.Int(), 10), nil
+		}
+	case t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uintptr:
+		appendValue = func(dst []byte, v reflect.Value) ([]byte, error) {
+			return strconv.AppendUint(dst, v.Uint(), 10), nil
+		}
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		bits := t.Bits()
+		prec := tag.prec
+		appendValue = func(dst []byte, v reflect.Value) ([]byte, error) {
+			return strconv.AppendFloat(dst, v.Float(), 'f', prec, bits), nil
+		}
+	default:
+		return nil, false
+	}
+
+	if !tag.omitEmpty {
+		return appendValue, true
+	}
+	return func(dst []byte, v reflect.Value) ([]byte, error) {
+		if v.IsZero() {
+			return dst, nil
+		}
+		return appendValue(dst, v)
+	}, true
+}
@@ -0,0 +1,138 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/netip"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// =============================================================================
+// Struct Encoding Tests
+// =============================================================================
+
+// upperText has a pointer-receiver MarshalText, to exercise addressable copies.
+type upperText string
+
+func (u *upperText) MarshalText() ([]byte, error) {
+	return []byte(strings.ToUpper(string(*u))), nil
+}
+
+// failingText always fails to marshal.
+type failingText struct{}
+
+func (failingText) MarshalText() ([]byte, error) {
+	return nil, errors.New("boom")
+}
+
+// TestEncoder_Basic tests header generation, formatting options and quoting.
+func TestEncoder_Basic(t *testing.T) {
+	type item struct {
+		decodeBase
+		Name  string     `csv:"name"`
+		Price float64    `csv:"price,prec=2"`
+		Ratio float32    `csv:"ratio"`
+		Qty   *uint16    `csv:"qty"`
+		Note  string     `csv:"note,omitempty"`
+		Addr  netip.Addr `csv:"addr"`
+		Code  upperText  `csv:"code"`
+		Day   time.Time  `csv:"day,layout=Jan 2, 2006"`
+		Skip  int        `csv:"-"`
+	}
+	qty := uint16(3)
+	items := []item{
+		{decodeBase{1}, `say "hi", bob`, 9.5, 0.25, &qty, "", netip.MustParseAddr("10.0.0.1"), "ab", time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), 9},
+		{decodeBase{-2}, " lead", 1234567.891, 1e-7, nil, "x\ny", netip.Addr{}, "", time.Time{}, 0},
+	}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	enc := NewEncoder(w)
+	if err := enc.Encode(items[:1]); err != nil {
+		t.Fatalf("Encode slice error: %v", err)
+	}
+	if err := enc.Encode(&items[1]); err != nil {
+		t.Fatalf("Encode pointer error: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush error: %v", err)
+	}
+
+	want := "id,name,price,ratio,qty,note,addr,code,day\n" +
+		"1,\"say \"\"hi\"\", bob\",9.50,0.25,3,,10.0.0.1,AB,\"Mar 5, 2024\"\n" +
+		"-2,\" lead\",1234567.89,0.0000001,,\"x\ny\",,,\"Jan 1, 0001\"\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Encode output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+// TestEncoder_RoundTrip verifies Decoder reads back what Encoder writes.
+func TestEncoder_RoundTrip(t *testing.T) {
+	age := uint8(30)
+	seen := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	records := []decodeRecord{
+		{
+			decodeBase: decodeBase{ID: 7}, Name: "Smith, \"J\"\nJr", Score: 0.1, Active: true, Age: &age,
+			Addr: netip.MustParseAddr("::1"), Born: time.Date(1990, 2, 3, 0, 0, 0, 0, time.UTC), Seen: &seen, Extra: " x",
+		},
+		{decodeBase: decodeBase{ID: 8}, Addr: netip.MustParseAddr("1.2.3.4"), Born: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, delim := range []string{"", "||"} {
+		var buf bytes.Buffer
+		w := NewWriter(&buf)
+		w.Delimiter = delim
+		if err := NewEncoder(w).Encode(records); err != nil {
+			t.Fatalf("Encode error: %v", err)
+		}
+		w.Flush()
+
+		r := NewReaderWithOptions(&buf, ReaderOptions{Header: true})
+		r.Delimiter = delim
+		dec := NewDecoder(r)
+		var got []decodeRecord
+		for {
+			var rec decodeRecord
+			if err := dec.Decode(&rec); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("Decode error: %v", err)
+			}
+			got = append(got, rec)
+		}
+		if !reflect.DeepEqual(got, records) {
+			t.Errorf("delimiter %q: round trip = %+v, want %+v", delim, got, records)
+		}
+	}
+}
+
+// TestEncoder_Errors tests marshaling errors and invalid values.
+func TestEncoder_Errors(t *testing.T) {
+	type bad struct {
+		A int
+		B failingText
+	}
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	enc := NewEncoder(w)
+	enc.OmitHeader = true
+	if err := enc.Encode(bad{A: 1}); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("Encode with failing marshaler: got %v", err)
+	}
+	w.Flush()
+	if buf.Len() != 0 {
+		t.Errorf("partial record written: %q", buf.String())
+	}
+
+	for _, v := range []any{42, []int{1}, (*bad)(nil), []*bad{nil}, struct{ C chan int }{}} {
+		if err := enc.Encode(v); err == nil {
+			t.Errorf("Encode(%T): got nil error", v)
+		}
+	}
+}
@@ -5,25 +5,44 @@ package simdcsv
 import (
 	"errors"
 	"fmt"
+	"strconv"
 )
 
 // Sentinel errors returned by [Reader]. These are compatible with [encoding/csv].
 var (
-	ErrBareQuote     = errors.New("bare \" in non-quoted-field")
-	ErrQuote         = errors.New("extraneous or missing \" in quoted-field")
-	ErrFieldCount    = errors.New("wrong number of fields")
-	ErrInputTooLarge = errors.New("input exceeds maximum allowed size")
+	ErrBareQuote       = errors.New("bare \" in non-quoted-field")
+	ErrQuote           = errors.New("extraneous or missing \" in quoted-field")
+	ErrFieldCount      = errors.New("wrong number of fields")
+	ErrInputTooLarge   = errors.New("input exceeds maximum allowed size")
+	ErrRecordTooLarge  = errors.New("record exceeds maximum buffer size")
+	ErrInvalidDelim    = errors.New("invalid field or comment delimiter")
+	ErrReaderReleased  = errors.New("read from released Reader")
+	ErrNoHeader        = errors.New("header mode is not enabled")
+	ErrDuplicateHeader = errors.New("duplicate header column")
+	ErrTooManyErrors   = errors.New("too many malformed records")
+	ErrRecordsReleased = errors.New("records before the read position are no longer buffered")
 )
 
-// DefaultMaxInputSize is the default maximum input size (2GB).
+// DefaultMaxInputSize is the default maximum size (2GB) of input held in memory
+// by ReadAll and random access, and of a window holding a long record.
 const DefaultMaxInputSize = 2 * 1024 * 1024 * 1024
 
 // ParseError represents a parsing error with location information.
+//
+// Offset, Record, Field and Excerpt add detail beyond encoding/csv for
+// locating the error in large inputs. For a record with the wrong number of
+// fields, Err is a *FieldCountError, which matches ErrFieldCount with
+// errors.Is, and the error is located at the start of the record.
 type ParseError struct {
 	StartLine int   // Line where the record started
 	Line      int   // Line where the error occurred
 	Column    int   // Column where the error occurred (1-indexed)
 	Err       error // Underlying error
+
+	Offset  int64  // Byte offset of the error in the input (0-indexed)
+	Record  int64  // Record number, counting the header but not comment lines (1-indexed)
+	Field   int    // Field within the record (0-indexed)
+	Excerpt string // Input around Offset, escaped with strconv.Quote
 }
 
 // Error returns a formatted error message with location information.
@@ -35,3 +54,62 @@ func (e *ParseError) Error() string {
 func (e *ParseError) Unwrap() error {
 	return e.Err
 }
+
+// FieldCountError describes a record with the wrong number of fields.
+// It is the Err of a ParseError and unwraps to ErrFieldCount.
+type FieldCountError struct {
+	Expected int // FieldsPerRecord, or the field count of the first record
+	Actual   int // field count of the record
+}
+
+// Error returns ErrFieldCount's message with the expected and actual counts.
+func (e *FieldCountError) Error() string {
+	return fmt.Sprintf("%v: expected %d, got %d", ErrFieldCount, e.Expected, e.Actual)
+}
+
+// Unwrap returns ErrFieldCount.
+func (e *FieldCountError) Unwrap() error {
+	return ErrFieldCount
+}
+
+// excerptRadius is the number of input bytes on each side of an error kept in ParseError.Excerpt.
+const excerptRadius = 16
+
+// parseErrorAt returns a ParseError for err at the window-relative position
+// pos, in field of the current record.
+func (r *Reader) parseErrorAt(lineNum int, pos uint64, field int, err error) *ParseError {
+	raw := r.state.rawBuffer
+	p := min(int(pos), len(raw)) //nolint:gosec // G115: pos is within the window
+	return &ParseError{
+		StartLine: lineNum,
+		Line:      lineNum,
+		Column:    r.inputColumn(pos),
+		Err:       err,
+		Offset:    r.inputOffset(pos),
+		Record:    r.state.recordCount,
+		Field:     field,
+		Excerpt:   strconv.Quote(string(raw[max(p-excerptRadius, 0):min(p+excerptRadius, len(raw))])),
+	}
+}
+
+// fieldErrorAt returns a ParseError for err in field i of the current record.
+func (r *Reader) fieldErrorAt(i int, err error) *ParseError {
+	row := r.state.parseResult.rows[r.state.currentRecordIndex-1]
+	field := r.getFieldsForRow(row, row.fieldCount)[i]
+	return r.parseErrorAt(row.lineNum, uint64(field.rawStart()), i, err)
+}
+
+// fieldIndexAt returns the index of the field of the current record that
+// contains the window-relative position pos.
+func (r *Reader) fieldIndexAt(pos uint64) int {
+	if r.state.parseResult == nil || r.state.currentRecordIndex == 0 {
+		return 0
+	}
+	row := r.state.parseResult.rows[r.state.currentRecordIndex-1]
+	fields := r.getFieldsForRow(row, row.fieldCount)
+	i := 0
+	for i+1 < len(fields) && uint64(fields[i+1].rawStart()) <= pos {
+		i++
+	}
+	return i
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/csv"
 	"errors"
 	"io"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -219,3 +220,63 @@ func TestErrFieldCount(t *testing.T) {
 		})
 	}
 }
+
+// TestParseError_Detail tests the offset, record, field and excerpt of errors.
+func TestParseError_Detail(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		comment rune
+		want    ParseError
+	}{
+		{
+			name:  "bare quote",
+			input: "a,b,c\nd,e\"f,g\n",
+			want:  ParseError{Err: ErrQuote, Offset: 8, Record: 2, Field: 1, Excerpt: `"a,b,c\nd,e\"f,g\n"`},
+		},
+		{
+			name:  "text after closing quote",
+			input: "a,b\nc,\"long quoted\"value\n",
+			want:  ParseError{Err: ErrQuote, Offset: 19, Record: 2, Field: 1, Excerpt: `"\nc,\"long quoted\"value\n"`},
+		},
+		{
+			name:    "field count after comment",
+			input:   "a,b\n# note\nc,d,e\n",
+			comment: '#',
+			want:    ParseError{Err: &FieldCountError{Expected: 2, Actual: 3}, Offset: 11, Record: 2, Excerpt: `"a,b\n# note\nc,d,e\n"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReader(strings.NewReader(tt.input))
+			r.Comment = tt.comment
+			_, err := r.ReadAll()
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("ReadAll error = %v, want *ParseError", err)
+			}
+			got := ParseError{Err: pe.Err, Offset: pe.Offset, Record: pe.Record, Field: pe.Field, Excerpt: pe.Excerpt}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	// Errors raised from a Row locate the field the same way
+	r := NewReader(strings.NewReader("a,b\n1,x\n"))
+	if _, err := r.ReadRow(); err != nil {
+		t.Fatalf("ReadRow error: %v", err)
+	}
+	row, err := r.ReadRow()
+	if err != nil {
+		t.Fatalf("ReadRow error: %v", err)
+	}
+	_, err = row.Int64(1)
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Offset != 6 || pe.Record != 2 || pe.Field != 1 {
+		t.Errorf("Int64 error = %+v, want offset 6, record 2, field 1", pe)
+	}
+	if fce := (&FieldCountError{Expected: 2, Actual: 3}); !errors.Is(fce, ErrFieldCount) {
+		t.Errorf("FieldCountError does not match ErrFieldCount")
+	}
+}
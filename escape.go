@@ -0,0 +1,206 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"math/bits"
+	"unicode/utf8"
+)
+
+// =============================================================================
+// Escape Character (Backslash-Escape Dialect)
+// =============================================================================
+//
+// MySQL's SELECT ... INTO OUTFILE and Hive mark special bytes with a preceding
+// escape character instead of RFC 4180 doubling: \" \, \\ and an escaped
+// line break all stand for the literal byte.
+//
+// Input with an escape character is scanned by scanBufferEscaped, a copy of
+// the chunk loop that also matches the escape character and clears the byte
+// following each escape from the quote, separator and newline masks, so an
+// escaped byte never toggles quote state, splits a field or ends a record.
+// Escapes pair up from the left (\\ is one literal backslash), and an escape
+// in the last byte of a chunk carries into the next chunk through
+// scanState.escaped. The default scan loop never looks at escapes.
+//
+// Chunks containing escapes are flagged in chunkHasDQ and the scan result
+// records hasEscapes, so the fields that overlap them are decoded by
+// appendUnescaped. Record building checks hasEscapes rather than Escape, so
+// a window without escapes is built exactly like one scanned without Escape.
+//
+// =============================================================================
+
+// validEscape reports whether escape can be used as the escape character.
+// Zero disables escaping. Otherwise escape must be ASCII so the scanner can
+// match it in SIMD, and must differ from the line breaks and other delimiters.
+func validEscape(escape, comma, comment, quote rune) bool {
+	if escape == 0 {
+		return true
+	}
+	return escape < utf8.RuneSelf && escape != '\r' && escape != '\n' &&
+		escape != comma && escape != comment && escape != quote
+}
+
+// escapeByte returns the configured escape character, or 0 if escaping is disabled.
+func (r *Reader) escapeByte() byte {
+	return byte(r.Escape)
+}
+
+// =============================================================================
+// Escape Scanning
+// =============================================================================
+
+// escapedPositions returns the positions escaped by the escape characters in escMask
+// and records in state whether the first byte of the next chunk is escaped.
+func escapedPositions(escMask uint64, state *scanState) uint64 {
+	var escaped uint64
+	if state.escaped {
+		// The previous chunk ended with an escape; its target is our first byte
+		escaped = 1
+		escMask &^= 1
+	}
+	state.escaped = false
+
+	for escMask != 0 {
+		pos := bits.TrailingZeros64(escMask)
+		if pos == simdChunkSize-1 {
+			state.escaped = true
+			break
+		}
+		target := uint64(1) << (pos + 1)
+		escaped |= target
+		// An escaped escape is literal and cannot escape the byte after it
+		escMask &^= uint64(1)<<pos | target
+	}
+	return escaped
+}
+
+// scanBufferEscaped processes the buffer like scanBufferWithGenerator,
+// clearing the bytes escaped by escapeChar from each chunk before processing it.
+func scanBufferEscaped(buf []byte, gen maskGenerator, escapeChar byte, term Terminator) *scanResult {
+	result := acquireScanResult((len(buf) + simdChunkSize - 1) / simdChunkSize)
+	result.terminator = term
+	scanChunksEscaped(buf, gen, escapeChar, 0, scanState{terminator: term}, result)
+	return result
+}
+
+// scanChunksEscaped is scanChunks for scans with an escape character.
+func scanChunksEscaped(buf []byte, gen maskGenerator, escapeChar byte, first int, state scanState, result *scanResult) {
+	resumeChunk := checkpointChunk(len(buf))
+	curMasks, curValidBits := chunkMasksAt(buf, gen, first)
+	for chunkIdx := first; chunkIdx < result.chunkCount; chunkIdx++ {
+		if chunkIdx == resumeChunk {
+			result.setCheckpoint(chunkIdx, state)
+		}
+		nextMasks, nextValidBits := chunkMasksAt(buf, gen, chunkIdx+1)
+		escMask := byteMaskAt(buf, chunkIdx*simdChunkSize, escapeChar)
+		if escMask != 0 || state.escaped {
+			curMasks = clearEscapedBytes(curMasks, escMask, &state)
+			result.hasEscapes = true
+			result.chunkHasDQ[chunkIdx] = true
+		}
+		processChunk(chunkIdx, curMasks, nextMasks, curValidBits, &state, result)
+		curMasks, curValidBits = nextMasks, nextValidBits
+	}
+
+	result.finalQuoted = state.quoted
+	result.lastChunkBits = len(buf) % simdChunkSize
+}
+
+// clearEscapedBytes removes the bytes escaped by escMask from the structural masks of a chunk.
+func clearEscapedBytes(m chunkMasks, escMask uint64, state *scanState) chunkMasks {
+	escaped := escapedPositions(escMask, state)
+	m.quote &^= escaped
+	m.sep &^= escaped
+	m.cr &^= escaped
+	m.nl &^= escaped
+	return m
+}
+
+// =============================================================================
+// Escape Decoding
+// =============================================================================
+
+// appendUnescaped appends content to dst, decoding escape sequences in addition
+// to the doubled quotes and CRLF pairs handled by transformContent.
+//
+// \n, \r and \t decode to LF, CR and tab; any other escaped byte stands for
+// itself. A field consisting of exactly \N is MySQL's NULL and decodes to the
+// empty string. A trailing escape with nothing after it is kept literally.
+func appendUnescaped(dst, content []byte, quote, escape byte, crlf bool) []byte {
+	if len(content) == 2 && content[0] == escape && content[1] == 'N' {
+		return dst
+	}
+
+	for i := 0; i < len(content); i++ {
+		b := content[i]
+		switch {
+		case b == escape && i+1 < len(content):
+			i++
+			dst = append(dst, unescapeByte(content[i]))
+		case b == quote && i+1 < len(content) && content[i+1] == quote:
+			dst = append(dst, quote)
+			i++
+		case crlf && b == '\r' && i+1 < len(content) && content[i+1] == '\n':
+			dst = append(dst, '\n')
+			i++
+		default:
+			dst = append(dst, b)
+		}
+	}
+	return dst
+}
+
+// isNullField reports whether field is MySQL's NULL: exactly Escape followed
+// by N, unquoted. Its value decodes to the empty string like an empty field.
+func (r *Reader) isNullField(field fieldInfo) bool {
+	escape := r.escapeByte()
+	if escape == 0 || field.flags&fieldFlagIsQuoted != 0 {
+		return false
+	}
+	content := r.getFieldContentWithTrim(field)
+	return len(content) == 2 && content[0] == escape && content[1] == 'N'
+}
+
+// unescapeByte returns the byte represented by an escape followed by b.
+func unescapeByte(b byte) byte {
+	switch b {
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	}
+	return b
+}
+
+// =============================================================================
+// Escape-Aware Quote Search
+// =============================================================================
+
+// closingQuoteIndex finds the closing quote of a quoted field, skipping escaped
+// bytes if the window has escapes.
+// Returns the index of the closing quote, or -1 if not found.
+func (r *Reader) closingQuoteIndex(data []byte, startAfterOpenQuote int) int {
+	if r.state.hasEscapes {
+		return findClosingQuoteEscaped(data, startAfterOpenQuote, r.quoteByte(), r.escapeByte())
+	}
+	return findClosingQuote(data, startAfterOpenQuote, r.quoteByte())
+}
+
+// findClosingQuoteEscaped is findClosingQuoteScalar with escaped bytes skipped.
+func findClosingQuoteEscaped(data []byte, startAfterOpenQuote int, quote, escape byte) int {
+	for i := startAfterOpenQuote; i < len(data); i++ {
+		switch data[i] {
+		case escape:
+			i++
+		case quote:
+			if !isEscapedQuote(data, i, quote) {
+				return i
+			}
+			i++
+		}
+	}
+	return -1
+}
@@ -3,12 +3,15 @@
 //nolint:gosec // G115: Integer conversions are safe - buffer size bounded by DefaultMaxInputSize (2GB)
 package simdcsv
 
+import "bytes"
+
 // isFirstNonCommentRecord reports whether this is the first non-comment record.
 func (r *Reader) isFirstNonCommentRecord() bool {
 	return r.state.nonCommentRecordCount == 0
 }
 
 // isCommentLine reports whether a row starts with the Comment character.
+// Multi-byte Comment runes are compared as their full UTF-8 sequence.
 func (r *Reader) isCommentLine(row rowInfo, _ int) bool {
 	if r.Comment == 0 || row.fieldCount == 0 {
 		return false
@@ -28,5 +31,5 @@ func (r *Reader) isCommentLine(row rowInfo, _ int) bool {
 		return false
 	}
 
-	return r.state.rawBuffer[rawStart] == byte(r.Comment)
+	return bytes.HasPrefix(r.state.rawBuffer[rawStart:], r.state.comment)
 }
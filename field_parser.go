@@ -29,6 +29,8 @@ import (
 //   - fieldStart: where the current field begins in the buffer
 //   - quoteAdjust: offset to skip opening quote (0 or 1)
 //   - lastClosingQuote: position of closing quote for length calculation
+//   - separatorLen: bytes to skip past a separator (more than 1 for multi-byte Comma)
+//   - dropCR, terminatorByte: how the configured Terminator ends a record
 //
 // =============================================================================
 
@@ -40,6 +42,9 @@ type parserState struct {
 	lastSepOrNewline int64  // last separator/newline position (-1 initially)
 	lastClosingQuote int64  // last closing quote position (-1 if none)
 	sawQuote         bool   // true if quote was seen in current field (for validation optimization)
+	separatorLen     uint64 // byte length of the separator sequence
+	dropCR           bool   // a CR before a record terminator is excluded from the field
+	terminatorByte   byte   // custom record terminator, or 0 for CR and LF
 }
 
 // newParserState creates an initialized parser state.
@@ -47,9 +52,20 @@ func newParserState() parserState {
 	return parserState{
 		lastSepOrNewline: -1,
 		lastClosingQuote: -1,
+		separatorLen:     1,
+		dropCR:           true,
 	}
 }
 
+// newParserStateFor creates a parser state for the separator and terminator scanned into sr.
+func newParserStateFor(sr *scanResult) parserState {
+	state := newParserState()
+	state.separatorLen = sr.separatorWidth()
+	state.dropCR = sr.terminator.dropsCR()
+	state.terminatorByte, _ = sr.terminator.customByte()
+	return state
+}
+
 // enterQuotedState transitions to the quoted state.
 func (s *parserState) enterQuotedState() {
 	s.quoted = true
@@ -63,8 +79,9 @@ func (s *parserState) exitQuotedState(quotePos uint64) {
 }
 
 // resetForNextField prepares state for parsing the next field.
-func (s *parserState) resetForNextField(delimiterPos uint64) {
-	s.fieldStart = delimiterPos + 1
+// The next field starts after the delimiterLen bytes of the delimiter.
+func (s *parserState) resetForNextField(delimiterPos, delimiterLen uint64) {
+	s.fieldStart = delimiterPos + delimiterLen
 	s.quoteAdjust = 0
 	s.lastSepOrNewline = int64(delimiterPos)
 	s.lastClosingQuote = -1
@@ -259,7 +276,7 @@ func parseBuffer(buf []byte, sr *scanResult) *parseResult {
 
 	ensureResultCapacity(result, len(buf), sr)
 
-	state := newParserState()
+	state := newParserStateFor(sr)
 	currentRowFirstField := 0
 	lineNum := 1
 
@@ -425,7 +442,11 @@ func handleNewlineEvent(buf []byte, absPos uint64, state *parserState, result *p
 
 // processNewline handles a newline character, either creating a row or skipping blank lines.
 func processNewline(buf []byte, absPos uint64, state *parserState, result *parseResult, rowFirstField, lineNum *int) {
-	if isBlankLine(*rowFirstField, len(result.fields), state.fieldStart, absPos) {
+	lineEnd := absPos
+	if state.dropCR && lineEnd > state.fieldStart && buf[lineEnd-1] == '\r' {
+		lineEnd-- // A CRLF blank line is blank too
+	}
+	if isBlankLine(*rowFirstField, len(result.fields), state.fieldStart, lineEnd) {
 		skipBlankLine(state, absPos, lineNum)
 		return
 	}
@@ -457,7 +478,11 @@ func recordField(buf []byte, absPos uint64, state *parserState, result *parseRes
 	bounds := computeFieldBounds(buf, absPos, state, isNewline)
 	containsQuote := state.sawQuote
 	result.fields = append(result.fields, newFieldInfo(bounds.start, bounds.length, bounds.rawEndDelta, bounds.isQuoted, containsQuote))
-	state.resetForNextField(absPos)
+	delimiterLen := state.separatorLen
+	if isNewline {
+		delimiterLen = 1
+	}
+	state.resetForNextField(absPos, delimiterLen)
 }
 
 // fieldBounds holds computed field boundary information.
@@ -471,7 +496,7 @@ type fieldBounds struct {
 // computeFieldBounds calculates the start, length, and metadata for a field.
 func computeFieldBounds(buf []byte, absPos uint64, state *parserState, isNewline bool) fieldBounds {
 	start := state.fieldStart + state.quoteAdjust
-	endPos := adjustEndForCRLF(buf, absPos, start, isNewline)
+	endPos := adjustEndForCRLF(buf, absPos, start, isNewline && state.dropCR)
 	fieldLen := computeFieldLength(endPos, start, state)
 	rawEndDelta := computeRawEndDelta(absPos, start, fieldLen)
 
@@ -548,7 +573,8 @@ func needsFinalization(buf []byte, state *parserState) bool {
 
 	// Empty final field after separator (no trailing newline)
 	lastChar := buf[bufLen-1]
-	lastCharIsNewline := lastChar == '\n' || lastChar == '\r'
+	lastCharIsNewline := lastChar == '\n' || lastChar == '\r' ||
+		(state.terminatorByte != 0 && lastChar == state.terminatorByte)
 	return state.fieldStart == bufLen && !lastCharIsNewline
 }
 
@@ -0,0 +1,161 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// =============================================================================
+// Header Mode
+// =============================================================================
+//
+// With ReaderOptions.Header set, the first record after any comment lines is
+// read as column names before the first record is returned by any read method.
+// The header counts as the first record for FieldsPerRecord, so with the
+// default of 0 every data record must have as many fields as the header.
+//
+// Column names are copied when the header is read, so they stay valid across
+// windows regardless of ZeroCopy and ReuseRecord.
+//
+// =============================================================================
+
+// DuplicateHeader selects how header mode handles repeated column names.
+// Names are compared after trimming and case folding, if enabled.
+type DuplicateHeader int
+
+const (
+	// DuplicateHeaderError fails reading the header with ErrDuplicateHeader.
+	DuplicateHeaderError DuplicateHeader = iota
+	// DuplicateHeaderFirst resolves a name to the first column carrying it.
+	DuplicateHeaderFirst
+	// DuplicateHeaderLast resolves a name to the last column carrying it.
+	DuplicateHeaderLast
+	// DuplicateHeaderRename renames later columns to name_2, name_3, and so on.
+	DuplicateHeaderRename
+)
+
+// Header returns the column names of the header row, reading it if no record
+// has been read yet. It returns nil if header mode is disabled, the input is
+// empty, or the header could not be read; in the last case the next read
+// returns the error. The slice must not be modified.
+func (r *Reader) Header() []string {
+	_ = r.ensureInitialized()
+	return r.state.header
+}
+
+// ColumnIndex returns the index of the column with the given name, or -1 if
+// there is none. The name is matched with the same trimming and case folding
+// as the header.
+func (r *Reader) ColumnIndex(name string) int {
+	if r.ensureInitialized() != nil {
+		return -1
+	}
+	if i, ok := r.state.headerIndex[r.headerKey(name)]; ok {
+		return i
+	}
+	return -1
+}
+
+// ReadMap reads one record and returns it keyed by column name.
+// Fields beyond the header are dropped, and columns missing from a short
+// record are absent from the map. Errors are those of Read, with the partial
+// record mapped in the same way; without header mode ReadMap returns ErrNoHeader.
+func (r *Reader) ReadMap() (map[string]string, error) {
+	if err := r.ensureInitialized(); err != nil {
+		return nil, err
+	}
+	if !r.opts.header {
+		return nil, ErrNoHeader
+	}
+
+	record, err := r.readNextRecord()
+	if record == nil {
+		return nil, err
+	}
+	m := make(map[string]string, len(r.state.mapColumns))
+	for _, i := range r.state.mapColumns {
+		if i < len(record) {
+			m[r.state.header[i]] = record[i]
+		}
+	}
+	return m, err
+}
+
+// readHeader reads the header row and builds the column index.
+// Errors other than cancellation are sticky, since no record can be
+// interpreted without the header.
+func (r *Reader) readHeader() error {
+	record, err := r.readRecord()
+	if err != nil && r.state.ctx != nil && err == r.state.ctx.Err() {
+		return err
+	}
+	r.state.headerRead = true
+	if err == io.EOF {
+		return nil
+	}
+	if err == nil {
+		err = r.setHeader(record)
+	}
+	r.state.headerErr = err
+	return err
+}
+
+// setHeader records the column names, resolving duplicates by policy.
+func (r *Reader) setHeader(record []string) error {
+	header := make([]string, len(record))
+	index := make(map[string]int, len(record))
+	columns := make([]int, 0, len(record))
+	for i, field := range record {
+		name := strings.Clone(field)
+		if r.opts.headerTrimSpace {
+			name = strings.TrimSpace(name)
+		}
+		key := r.headerKey(name)
+
+		first, dup := index[key]
+		switch {
+		case !dup:
+			columns = append(columns, i)
+		case r.opts.headerDuplicates == DuplicateHeaderFirst:
+			// Keep the first column's mapping
+		case r.opts.headerDuplicates == DuplicateHeaderLast:
+			columns[slices.Index(columns, first)] = i
+		case r.opts.headerDuplicates == DuplicateHeaderRename:
+			base := name
+			for n := 2; dup; n++ {
+				name = base + "_" + strconv.Itoa(n)
+				key = r.headerKey(name)
+				_, dup = index[key]
+			}
+			columns = append(columns, i)
+		default:
+			return r.fieldErrorAt(i, fmt.Errorf("%w %q", ErrDuplicateHeader, name))
+		}
+
+		header[i] = name
+		if !dup || r.opts.headerDuplicates != DuplicateHeaderFirst {
+			index[key] = i
+		}
+	}
+
+	r.state.header = header
+	r.state.headerIndex = index
+	r.state.mapColumns = columns
+	return nil
+}
+
+// headerKey returns the lookup key of a column name.
+func (r *Reader) headerKey(name string) string {
+	if r.opts.headerTrimSpace {
+		name = strings.TrimSpace(name)
+	}
+	if r.opts.headerFold {
+		name = strings.ToLower(name)
+	}
+	return name
+}
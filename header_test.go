@@ -0,0 +1,184 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// =============================================================================
+// Header Mode Tests
+// =============================================================================
+
+// TestHeader_ReadMap tests header detection, comments and map records.
+func TestHeader_ReadMap(t *testing.T) {
+	input := "# exported\nid,name,city\n1,alice,paris\n# skipped\n2,bob,\n"
+	r := NewReaderWithOptions(strings.NewReader(input), ReaderOptions{Header: true})
+	r.Comment = '#'
+
+	if got, want := r.Header(), []string{"id", "name", "city"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Header() = %q, want %q", got, want)
+	}
+	var got []map[string]string
+	for {
+		m, err := r.ReadMap()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("ReadMap error: %v", err)
+		}
+		got = append(got, m)
+	}
+	want := []map[string]string{
+		{"id": "1", "name": "alice", "city": "paris"},
+		{"id": "2", "name": "bob", "city": ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadMap records = %v, want %v", got, want)
+	}
+	if line, _ := r.FieldPos(0); line != 5 {
+		t.Errorf("FieldPos line after header = %d, want 5", line)
+	}
+}
+
+// TestHeader_ReadSkipsHeader verifies the header is not returned by other read methods.
+func TestHeader_ReadSkipsHeader(t *testing.T) {
+	input := "a,b\n1,2\n3,4\n"
+	r := NewReaderWithOptions(strings.NewReader(input), ReaderOptions{Header: true, ZeroCopy: true})
+	records, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll error: %v", err)
+	}
+	if want := [][]string{{"1", "2"}, {"3", "4"}}; !reflect.DeepEqual(records, want) {
+		t.Errorf("ReadAll = %q, want %q", records, want)
+	}
+	if got := r.Header(); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Header() = %q, want [a b]", got)
+	}
+}
+
+// TestHeader_FieldsPerRecord verifies the header sets the expected field count.
+func TestHeader_FieldsPerRecord(t *testing.T) {
+	r := NewReaderWithOptions(strings.NewReader("a,b,c\n1,2\n"), ReaderOptions{Header: true})
+	m, err := r.ReadMap()
+	if !errors.Is(err, ErrFieldCount) {
+		t.Fatalf("ReadMap error: got %v, want ErrFieldCount", err)
+	}
+	if want := map[string]string{"a": "1", "b": "2"}; !reflect.DeepEqual(m, want) {
+		t.Errorf("ReadMap with short record = %v, want %v", m, want)
+	}
+
+	r = NewReaderWithOptions(strings.NewReader("a,b,c\n1,2\n"), ReaderOptions{Header: true})
+	r.FieldsPerRecord = 2
+	if _, err := r.Read(); !errors.Is(err, ErrFieldCount) {
+		t.Fatalf("Read with mismatched header: got %v, want ErrFieldCount", err)
+	}
+	if _, err := r.Read(); !errors.Is(err, ErrFieldCount) {
+		t.Errorf("header error is not sticky: got %v", err)
+	}
+}
+
+// TestHeader_ColumnIndex tests name matching options and duplicate policies.
+func TestHeader_ColumnIndex(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   ReaderOptions
+		header []string
+		lookup map[string]int
+		record map[string]string
+	}{
+		{
+			name:   "exact",
+			opts:   ReaderOptions{HeaderDuplicates: DuplicateHeaderFirst},
+			header: []string{" ID", "Name", "id", "Name"},
+			lookup: map[string]int{"Name": 1, "name": -1, "ID": -1, " ID": 0, "id": 2},
+			record: map[string]string{" ID": "1", "Name": "2", "id": "3"},
+		},
+		{
+			name:   "fold and trim",
+			opts:   ReaderOptions{HeaderCaseInsensitive: true, HeaderTrimSpace: true, HeaderDuplicates: DuplicateHeaderLast},
+			header: []string{"ID", "Name", "id", "Name"},
+			lookup: map[string]int{"name": 3, " NAME ": 3, "id": 2},
+			record: map[string]string{"id": "3", "Name": "4"},
+		},
+		{
+			name:   "rename",
+			opts:   ReaderOptions{HeaderTrimSpace: true, HeaderDuplicates: DuplicateHeaderRename},
+			header: []string{"ID", "Name", "id", "Name_2"},
+			lookup: map[string]int{"Name": 1, "Name_2": 3, "missing": -1},
+			record: map[string]string{"ID": "1", "Name": "2", "id": "3", "Name_2": "4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Header = true
+			r := NewReaderWithOptions(strings.NewReader(" ID,Name,id,Name\n1,2,3,4\n"), tt.opts)
+			if got := r.Header(); !reflect.DeepEqual(got, tt.header) {
+				t.Errorf("Header() = %q, want %q", got, tt.header)
+			}
+			for name, want := range tt.lookup {
+				if got := r.ColumnIndex(name); got != want {
+					t.Errorf("ColumnIndex(%q) = %d, want %d", name, got, want)
+				}
+			}
+			m, err := r.ReadMap()
+			if err != nil {
+				t.Fatalf("ReadMap error: %v", err)
+			}
+			if !reflect.DeepEqual(m, tt.record) {
+				t.Errorf("ReadMap = %v, want %v", m, tt.record)
+			}
+		})
+	}
+}
+
+// TestHeader_Errors tests duplicate names, empty input and disabled header mode.
+func TestHeader_Errors(t *testing.T) {
+	r := NewReaderWithOptions(strings.NewReader("a,b,a\n1,2,3\n"), ReaderOptions{Header: true})
+	_, err := r.Read()
+	var pe *ParseError
+	if !errors.As(err, &pe) || !errors.Is(err, ErrDuplicateHeader) || pe.Column != 5 {
+		t.Fatalf("duplicate header: got %v, want ErrDuplicateHeader at column 5", err)
+	}
+	if r.Header() != nil || r.ColumnIndex("a") != -1 {
+		t.Errorf("Header() after error = %q, want nil", r.Header())
+	}
+
+	r = NewReaderWithOptions(strings.NewReader(""), ReaderOptions{Header: true})
+	if _, err := r.ReadMap(); err != io.EOF {
+		t.Errorf("ReadMap on empty input: got %v, want io.EOF", err)
+	}
+	if records, err := r.ReadAll(); records != nil || err != nil {
+		t.Errorf("ReadAll on empty input = %q, %v; want nil, nil", records, err)
+	}
+
+	r = NewReader(strings.NewReader("a\n"))
+	if _, err := r.ReadMap(); err != ErrNoHeader {
+		t.Errorf("ReadMap without header mode: got %v, want ErrNoHeader", err)
+	}
+	if r.Header() != nil {
+		t.Errorf("Header() without header mode = %q, want nil", r.Header())
+	}
+}
+
+// TestHeader_Reset verifies Reset reads the header of the new source.
+func TestHeader_Reset(t *testing.T) {
+	r := NewReaderWithOptions(strings.NewReader("a,b\n1,2\n"), ReaderOptions{Header: true})
+	if _, err := r.ReadMap(); err != nil {
+		t.Fatalf("ReadMap error: %v", err)
+	}
+	r.Reset(strings.NewReader("c,d\n3,4\n"))
+	m, err := r.ReadMap()
+	if err != nil {
+		t.Fatalf("ReadMap after Reset error: %v", err)
+	}
+	if want := map[string]string{"c": "3", "d": "4"}; !reflect.DeepEqual(m, want) {
+		t.Errorf("ReadMap after Reset = %v, want %v", m, want)
+	}
+}
@@ -0,0 +1,243 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"unsafe"
+
+	"simd/archsimd"
+)
+
+// =============================================================================
+// Typed Field Parsing
+// =============================================================================
+//
+// Numbers are parsed straight from the field bytes. The common shapes, plain
+// decimal integers of up to 19 digits and decimals of up to 15 significant
+// digits without an exponent, take a fast path: digits are validated with
+// AVX-512 (or 8 at a time in a 64-bit register) and converted 8 digits per
+// multiply. Every other input falls back to strconv on a string aliasing the
+// bytes, so results and errors are exactly those of strconv.
+//
+// =============================================================================
+
+// Int64 parses field i as a base-10 int64, like strconv.ParseInt(s, 10, 64).
+// Errors are ParseErrors locating the field, wrapping a *strconv.NumError or
+// the field's quote validation error.
+func (row *Row) Int64(i int) (int64, error) {
+	return parseField(row, i, parseInt64)
+}
+
+// Float64 parses field i like strconv.ParseFloat(s, 64). Errors are as for Int64.
+func (row *Row) Float64(i int) (float64, error) {
+	return parseField(row, i, parseFloat64)
+}
+
+// Bool parses field i like strconv.ParseBool. Errors are as for Int64.
+func (row *Row) Bool(i int) (bool, error) {
+	return parseField(row, i, func(b []byte) (bool, error) {
+		return strconv.ParseBool(bytesString(b))
+	})
+}
+
+// parseField converts field i with parse. An unescaped copy of a quoted
+// field is made at the end of recordBuffer and dropped afterwards.
+func parseField[T any](row *Row, i int, parse func([]byte) (T, error)) (T, error) {
+	field, err := row.validated(i)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	r := row.r
+	mark := len(r.state.recordBuffer)
+	v, err := parse(r.fieldBytes(field))
+	r.state.recordBuffer = r.state.recordBuffer[:mark]
+	if err != nil {
+		return v, r.fieldErrorAt(i, err)
+	}
+	return v, nil
+}
+
+// ColumnError reports a value that ParseInt64Column could not parse.
+type ColumnError struct {
+	Index int   // index of the value within the column
+	Err   error // the *strconv.NumError
+}
+
+// Error returns a message with the index of the value.
+func (e *ColumnError) Error() string {
+	return fmt.Sprintf("column value %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying conversion error.
+func (e *ColumnError) Unwrap() error {
+	return e.Err
+}
+
+// ParseInt64Column parses each field as a base-10 int64, like Row.Int64.
+// It stops at the first malformed value and returns the values before it
+// with a *ColumnError.
+func ParseInt64Column(fields [][]byte) ([]int64, error) {
+	out := make([]int64, len(fields))
+	for i, b := range fields {
+		n, err := parseInt64(b)
+		if err != nil {
+			return out[:i], &ColumnError{Index: i, Err: err}
+		}
+		out[i] = n
+	}
+	return out, nil
+}
+
+// =============================================================================
+// Integer Parsing
+// =============================================================================
+
+// maxFastDigits is the most digits whose value always fits in a uint64.
+const maxFastDigits = 19
+
+// parseInt64 parses b like strconv.ParseInt(string(b), 10, 64).
+func parseInt64(b []byte) (int64, error) {
+	digits := b
+	neg := false
+	if len(digits) > 0 && (digits[0] == '-' || digits[0] == '+') {
+		neg = digits[0] == '-'
+		digits = digits[1:]
+	}
+
+	if len(digits) > 0 && len(digits) <= maxFastDigits {
+		if n, ok := parseDigits(digits); ok {
+			if !neg && n <= math.MaxInt64 {
+				return int64(n), nil
+			}
+			if neg && n <= -math.MinInt64 {
+				return -int64(n), nil //nolint:gosec // G115: n <= 1<<63, and -(1<<63) wraps to MinInt64
+			}
+		}
+	}
+	return strconv.ParseInt(bytesString(b), 10, 64)
+}
+
+// parseDigits converts up to maxFastDigits decimal digits, reporting false
+// if any byte is not a digit.
+func parseDigits(b []byte) (uint64, bool) {
+	if useAVX512 && len(b) >= simdDigitsMinLen {
+		if !allDigitsSIMD(b) {
+			return 0, false
+		}
+	} else if !allDigitsSWAR(b) {
+		return 0, false
+	}
+
+	var n uint64
+	for len(b) >= 8 {
+		n = n*100000000 + eightDigitsValue(binary.LittleEndian.Uint64(b))
+		b = b[8:]
+	}
+	for _, c := range b {
+		n = n*10 + uint64(c-'0')
+	}
+	return n, true
+}
+
+// simdDigitsMinLen is the minimum length validated with AVX-512.
+// Shorter runs are checked in one or two 64-bit words.
+const simdDigitsMinLen = 16
+
+// Cached bounds of the ASCII digits (initialized in init() when AVX-512 is available).
+var (
+	cachedDigitLow  archsimd.Int8x64
+	cachedDigitHigh archsimd.Int8x64
+)
+
+// allDigitsSIMD reports whether b, at most 64 bytes, consists of ASCII digits.
+func allDigitsSIMD(b []byte) bool {
+	chunk := archsimd.LoadInt8x64SlicePart(bytesToInt8Slice(b))
+	// Bytes >= 0x80 are negative as int8, so they fail the lower bound
+	digits := chunk.Greater(cachedDigitLow).ToBits() & chunk.Less(cachedDigitHigh).ToBits()
+	valid := uint64(1)<<len(b) - 1
+	return digits&valid == valid
+}
+
+// allDigitsSWAR reports whether b consists of ASCII digits, 8 bytes at a time.
+func allDigitsSWAR(b []byte) bool {
+	for len(b) >= 8 {
+		if !isEightDigits(binary.LittleEndian.Uint64(b)) {
+			return false
+		}
+		b = b[8:]
+	}
+	for _, c := range b {
+		if c-'0' > 9 {
+			return false
+		}
+	}
+	return true
+}
+
+// isEightDigits reports whether all 8 bytes of v are ASCII digits.
+func isEightDigits(v uint64) bool {
+	return (v&0xF0F0F0F0F0F0F0F0)|(((v+0x0606060606060606)&0xF0F0F0F0F0F0F0F0)>>4) == 0x3333333333333333
+}
+
+// eightDigitsValue converts 8 ASCII digits, loaded little-endian, to their value.
+// Adjacent digits are combined pairwise, then into 4-digit and 8-digit groups.
+func eightDigitsValue(v uint64) uint64 {
+	v -= 0x3030303030303030
+	v = v*10 + v>>8
+	return ((v&0x000000FF000000FF)*(100+1000000<<32) + (v>>16&0x000000FF000000FF)*(1+10000<<32)) >> 32
+}
+
+// =============================================================================
+// Float Parsing
+// =============================================================================
+
+// maxExactDigits is the most significant digits always exactly representable
+// in a float64 mantissa.
+const maxExactDigits = 15
+
+// exactPow10 holds the powers of ten that are exact in a float64.
+var exactPow10 = [...]float64{1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10, 1e11, 1e12, 1e13, 1e14, 1e15}
+
+// parseFloat64 parses b like strconv.ParseFloat(string(b), 64).
+// A decimal without exponent and with at most maxExactDigits digits is an
+// exact integer divided by an exact power of ten, which one IEEE division
+// rounds correctly.
+func parseFloat64(b []byte) (float64, error) {
+	digits := b
+	neg := false
+	if len(digits) > 0 && (digits[0] == '-' || digits[0] == '+') {
+		neg = digits[0] == '-'
+		digits = digits[1:]
+	}
+
+	intPart, fracPart := digits, []byte(nil)
+	for i, c := range digits {
+		if c == '.' {
+			intPart, fracPart = digits[:i], digits[i+1:]
+			break
+		}
+	}
+	if n := len(intPart) + len(fracPart); n > 0 && n <= maxExactDigits {
+		whole, ok1 := parseDigits(intPart)
+		frac, ok2 := parseDigits(fracPart)
+		if ok1 && ok2 {
+			scale := exactPow10[len(fracPart)]
+			f := float64(whole*uint64(scale)+frac) / scale //nolint:gosec // G115: below 1e15, exact
+			if neg {
+				f = -f
+			}
+			return f, nil
+		}
+	}
+	return strconv.ParseFloat(bytesString(b), 64)
+}
+
+// bytesString returns a string aliasing b, for parsing without a copy.
+func bytesString(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
@@ -0,0 +1,132 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// =============================================================================
+// Typed Field Parsing Tests
+// =============================================================================
+
+// TestParseInt64_MatchesStrconv compares the fast paths against strconv.ParseInt.
+func TestParseInt64_MatchesStrconv(t *testing.T) {
+	inputs := []string{
+		"0", "7", "-7", "+7", "00000042", "12345678", "123456789",
+		"1234567890123456", "9223372036854775807", "-9223372036854775808",
+		"9223372036854775808", "-9223372036854775809", "99999999999999999999",
+		"0000000000000000000000001", "", "-", "+", "1_000", "12a4", "1234567a",
+		"12345678901234x6", " 1", "1 ", "1.5", "\xff2", "１",
+	}
+	for _, in := range inputs {
+		got, gotErr := parseInt64([]byte(in))
+		want, wantErr := strconv.ParseInt(in, 10, 64)
+		if got != want || !reflect.DeepEqual(gotErr, wantErr) {
+			t.Errorf("parseInt64(%q) = %d, %v; want %d, %v", in, got, gotErr, want, wantErr)
+		}
+	}
+}
+
+// TestParseFloat64_MatchesStrconv compares the fast paths against strconv.ParseFloat.
+func TestParseFloat64_MatchesStrconv(t *testing.T) {
+	inputs := []string{
+		"0", "-0", "1.5", "-1.5", "+2.25", "0.1", "0.3", ".5", "5.", "123456789.123456",
+		"999999999999999", "9999999999999999", "0.000000000000001", "1e10", "-2.5E-3",
+		"Inf", "NaN", "0x1p-2", "1_000.5", "", ".", "-", "1.2.3", "1..2", "abc",
+		"12345678.1234567", "1e400",
+	}
+	for _, in := range inputs {
+		got, gotErr := parseFloat64([]byte(in))
+		want, wantErr := strconv.ParseFloat(in, 64)
+		same := got == want || (math.IsNaN(got) && math.IsNaN(want))
+		if !same || math.Signbit(got) != math.Signbit(want) || !reflect.DeepEqual(gotErr, wantErr) {
+			t.Errorf("parseFloat64(%q) = %v, %v; want %v, %v", in, got, gotErr, want, wantErr)
+		}
+	}
+}
+
+// TestRow_TypedAccessors tests Int64, Float64 and Bool on quoted and unquoted fields.
+func TestRow_TypedAccessors(t *testing.T) {
+	for _, zeroCopy := range []bool{false, true} {
+		r := NewReaderWithOptions(strings.NewReader("\"-12\",3.75,true,\"1\"\"2\"\n"), ReaderOptions{ZeroCopy: zeroCopy})
+		row, err := r.ReadRow()
+		if err != nil {
+			t.Fatalf("ReadRow error: %v", err)
+		}
+		if n, err := row.Int64(0); n != -12 || err != nil {
+			t.Errorf("Int64(0) = %d, %v; want -12", n, err)
+		}
+		if f, err := row.Float64(1); f != 3.75 || err != nil {
+			t.Errorf("Float64(1) = %v, %v; want 3.75", f, err)
+		}
+		if b, err := row.Bool(2); !b || err != nil {
+			t.Errorf("Bool(2) = %v, %v; want true", b, err)
+		}
+		if got := row.Field(3); got != `1"2` {
+			t.Errorf("Field(3) = %q, want %q", got, `1"2`)
+		}
+		if _, err := row.Int64(3); !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("Int64(3) error = %v, want ErrSyntax", err)
+		}
+		if len(r.state.recordBuffer) != 0 {
+			t.Errorf("recordBuffer holds %d bytes after typed accessors", len(r.state.recordBuffer))
+		}
+	}
+}
+
+// TestRow_TypedAccessorErrors tests that conversion errors locate the field.
+func TestRow_TypedAccessorErrors(t *testing.T) {
+	r := NewReader(strings.NewReader("a,b\n1,x\n"))
+	if _, err := r.ReadRow(); err != nil {
+		t.Fatalf("ReadRow error: %v", err)
+	}
+	row, err := r.ReadRow()
+	if err != nil {
+		t.Fatalf("ReadRow error: %v", err)
+	}
+
+	_, err = row.Int64(1)
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Int64(1) error = %v, want *ParseError", err)
+	}
+	line, column := r.FieldPos(1)
+	if pe.Line != line || pe.Column != column {
+		t.Errorf("error at %d:%d, want %d:%d", pe.Line, pe.Column, line, column)
+	}
+	var ne *strconv.NumError
+	if !errors.As(err, &ne) || ne.Num != "x" {
+		t.Errorf("Int64(1) error = %v, want *strconv.NumError for %q", err, "x")
+	}
+	if _, err := row.Float64(1); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Float64(1) error = %v, want ErrSyntax", err)
+	}
+	if _, err := row.Bool(0); err != nil {
+		t.Errorf("Bool(0) error = %v, want nil", err)
+	}
+}
+
+// TestParseInt64Column tests bulk parsing and the index of a malformed value.
+func TestParseInt64Column(t *testing.T) {
+	fields := [][]byte{[]byte("1"), []byte("-22"), []byte("3333333333333333333"), []byte("4x"), []byte("5")}
+
+	got, err := ParseInt64Column(fields[:3])
+	if want := []int64{1, -22, 3333333333333333333}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseInt64Column = %v, %v; want %v", got, err, want)
+	}
+
+	got, err = ParseInt64Column(fields)
+	var ce *ColumnError
+	if !errors.As(err, &ce) || ce.Index != 3 || !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("ParseInt64Column error = %v, want *ColumnError at index 3", err)
+	}
+	if len(got) != 3 {
+		t.Errorf("ParseInt64Column returned %d values before the error, want 3", len(got))
+	}
+}
@@ -0,0 +1,297 @@
+//go:build goexperiment.simd && amd64
+
+//nolint:gosec // G115: Integer conversions are safe - values bounded by buffer size (max ~2GB)
+package simdcsv
+
+import (
+	"bytes"
+	"math/bits"
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// =============================================================================
+// Parallel Scanning and Parsing
+// =============================================================================
+//
+// Large buffers are split into pieces of ReaderOptions.ChunkSize bytes that are
+// scanned and parsed on separate goroutines:
+//
+//  1. Quote count: each piece counts its quote bytes. Every quote toggles the
+//     scanner's quote state except escaped pairs, which toggle it twice, so the
+//     prefix parity of the counts is the exact quote state at each piece start.
+//  2. Scan: each piece is scanned with its known starting state. Pieces are
+//     64-byte aligned and read one chunk of lookahead from the shared buffer,
+//     so boundary double quotes and CRLF pairs resolve as in the serial scan.
+//  3. Parse: the buffer is re-split at record boundaries (newlines outside
+//     quotes), each segment is parsed independently, and the fieldInfo/rowInfo
+//     results are stitched together in order.
+//
+// The result is identical to scanBuffer followed by parseBuffer.
+//
+// =============================================================================
+
+// shouldParallelize reports whether buf is large enough to split into pieces of pieceSize.
+func shouldParallelize(bufLen, pieceSize int) bool {
+	return pieceSize > 0 && bufLen > pieceSize && runtime.GOMAXPROCS(0) > 1
+}
+
+// alignPieceSize rounds pieceSize up to a whole number of scan chunks.
+func alignPieceSize(pieceSize int) int {
+	return (pieceSize + simdChunkSize - 1) / simdChunkSize * simdChunkSize
+}
+
+// runParallel calls fn(0..n-1) on up to GOMAXPROCS goroutines and waits for completion.
+func runParallel(n int, fn func(i int)) {
+	workers := min(runtime.GOMAXPROCS(0), n)
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				fn(i)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// =============================================================================
+// Parallel Scan
+// =============================================================================
+
+// scanBufferParallel scans buf in concurrent pieces of pieceSize bytes.
+func scanBufferParallel(buf []byte, separatorChar, quoteChar byte, term Terminator, pieceSize int) *scanResult {
+	pieceSize = alignPieceSize(pieceSize)
+	chunkCount := (len(buf) + simdChunkSize - 1) / simdChunkSize
+	pieceChunks := pieceSize / simdChunkSize
+	pieceCount := (chunkCount + pieceChunks - 1) / pieceChunks
+
+	result := acquireScanResult(chunkCount)
+	if len(buf)%simdChunkSize != 0 {
+		result.lastChunkBits = len(buf) % simdChunkSize
+	}
+	result.terminator = term
+
+	gen := newMaskGenerator(separatorChar, quoteChar, term.newlineByte())
+
+	// Phase 1: quote counts per piece
+	quoteCounts := make([]int, pieceCount)
+	runParallel(pieceCount, func(i int) {
+		start, end := i*pieceSize, min((i+1)*pieceSize, len(buf))
+		quoteCounts[i] = bytes.Count(buf[start:end], []byte{quoteChar})
+	})
+
+	// Resolve each piece's starting state from the prefix quote parity
+	states := make([]scanState, pieceCount)
+	states[0].terminator = term
+	quotes := 0
+	for i := 1; i < pieceCount; i++ {
+		quotes += quoteCounts[i-1]
+		states[i] = pieceStartState(buf, i*pieceSize, quotes, quoteChar)
+		states[i].terminator = term
+		if buf[i*pieceSize-1] == '\r' {
+			states[i].prevCR = 1
+		}
+	}
+
+	// Phase 2: scan pieces into disjoint chunk ranges of result
+	views := make([]scanResult, pieceCount)
+	runParallel(pieceCount, func(i int) {
+		first := i * pieceChunks
+		last := min(first+pieceChunks, chunkCount)
+		views[i] = scanResult{
+			quoteMasks:     result.quoteMasks[first:last],
+			separatorMasks: result.separatorMasks[first:last],
+			newlineMasks:   result.newlineMasks[first:last],
+			chunkHasDQ:     result.chunkHasDQ[first:last],
+			chunkHasQuote:  result.chunkHasQuote[first:last],
+		}
+		state := states[i]
+		scanChunkRange(buf, gen, first, last, &state, &views[i])
+		views[i].finalQuoted = state.quoted
+	})
+
+	for i := range views {
+		result.hasQuotes = result.hasQuotes || views[i].hasQuotes
+		result.hasCR = result.hasCR || views[i].hasCR
+		result.separatorCount += views[i].separatorCount
+		result.newlineCount += views[i].newlineCount
+	}
+	result.finalQuoted = views[pieceCount-1].finalQuoted
+	return result
+}
+
+// pieceStartState returns the scanner state at offset given the number of quotes before it.
+// An escaped pair split across the boundary leaves the state quoted and skips the second quote.
+func pieceStartState(buf []byte, offset, quotesBefore int, quoteChar byte) scanState {
+	if buf[offset-1] == quoteChar && buf[offset] == quoteChar {
+		// Quoted before the first quote of the pair: boundary double quote
+		if (quotesBefore-1)%2 == 1 {
+			return scanState{quoted: ^uint64(0), skipNextQuote: true}
+		}
+	}
+	if quotesBefore%2 == 1 {
+		return scanState{quoted: ^uint64(0)}
+	}
+	return scanState{}
+}
+
+// scanChunkRange scans chunks [first, last) of buf into view, whose slices start at chunk first.
+// Lookahead masks are read from buf past last, matching the serial scan.
+func scanChunkRange(buf []byte, gen maskGenerator, first, last int, state *scanState, view *scanResult) {
+	curMasks, curValidBits := chunkMasksAt(buf, gen, first)
+	for chunkIdx := first; chunkIdx < last; chunkIdx++ {
+		nextMasks, nextValidBits := chunkMasksAt(buf, gen, chunkIdx+1)
+		processChunk(chunkIdx-first, curMasks, nextMasks, curValidBits, state, view)
+		curMasks, curValidBits = nextMasks, nextValidBits
+	}
+}
+
+// =============================================================================
+// Parallel Parse
+// =============================================================================
+
+// parseBufferParallel parses buf in concurrent segments of roughly segmentSize bytes.
+// Segments start at record boundaries so each one can be parsed with fresh parser state.
+func parseBufferParallel(buf []byte, sr *scanResult, segmentSize int) *parseResult {
+	bounds := segmentBoundaries(buf, sr, segmentSize)
+	segmentCount := len(bounds) - 1
+	if segmentCount <= 1 {
+		return parseBuffer(buf, sr)
+	}
+
+	results := make([]*parseResult, segmentCount)
+	lines := make([]int, segmentCount)
+	runParallel(segmentCount, func(i int) {
+		results[i], lines[i] = parseSegment(buf[:bounds[i+1]], sr, bounds[i])
+	})
+
+	// Stitch segment results in order, rebasing field indices and line numbers
+	result := results[0]
+	totalFields, totalRows := 0, 0
+	for _, seg := range results {
+		totalFields += len(seg.fields)
+		totalRows += len(seg.rows)
+	}
+	result.fields = slices.Grow(result.fields, totalFields-len(result.fields))
+	result.rows = slices.Grow(result.rows, totalRows-len(result.rows))
+
+	lineBase := lines[0]
+	for i := 1; i < segmentCount; i++ {
+		seg := results[i]
+		fieldBase := len(result.fields)
+		result.fields = append(result.fields, seg.fields...)
+		for _, row := range seg.rows {
+			row.firstField += fieldBase
+			row.lineNum += lineBase
+			result.rows = append(result.rows, row)
+		}
+		lineBase += lines[i]
+		seg.release()
+	}
+	return result
+}
+
+// segmentBoundaries returns record-aligned split offsets for buf, starting at 0 and ending at len(buf).
+// Each boundary follows the first newline (outside quotes) at or after a multiple of segmentSize.
+func segmentBoundaries(buf []byte, sr *scanResult, segmentSize int) []int {
+	bounds := []int{0}
+	for target := segmentSize; target < len(buf); {
+		pos := nextNewline(sr, target)
+		if pos < 0 || pos+1 >= len(buf) {
+			break
+		}
+		bounds = append(bounds, pos+1)
+		target = pos + 1 + segmentSize
+	}
+	return append(bounds, len(buf))
+}
+
+// nextNewline returns the position of the first newline bit at or after from, or -1.
+func nextNewline(sr *scanResult, from int) int {
+	chunkIdx := from / simdChunkSize
+	if chunkIdx >= sr.chunkCount {
+		return -1
+	}
+	mask := sr.newlineMasks[chunkIdx] &^ (uint64(1)<<(from%simdChunkSize) - 1)
+	for {
+		if mask != 0 {
+			return chunkIdx*simdChunkSize + bits.TrailingZeros64(mask)
+		}
+		chunkIdx++
+		if chunkIdx >= sr.chunkCount {
+			return -1
+		}
+		mask = sr.newlineMasks[chunkIdx]
+	}
+}
+
+// parseSegment parses buf[start:] where start is a record boundary.
+// Rows are numbered from line 1 of the segment; the number of lines consumed is returned.
+func parseSegment(buf []byte, sr *scanResult, start int) (*parseResult, int) {
+	result := parseResultPool.Get().(*parseResult)
+	result.reset()
+
+	firstChunk := start / simdChunkSize
+	lastChunk := (len(buf) + simdChunkSize - 1) / simdChunkSize
+
+	// Size from the segment's own structural counts
+	separators, newlines := 0, 0
+	for chunkIdx := firstChunk; chunkIdx < lastChunk; chunkIdx++ {
+		separators += bits.OnesCount64(sr.separatorMasks[chunkIdx])
+		newlines += bits.OnesCount64(sr.newlineMasks[chunkIdx])
+	}
+	if cap(result.fields) < separators+newlines+1 {
+		result.fields = make([]fieldInfo, 0, separators+newlines+1)
+	}
+	if cap(result.rows) < newlines+1 {
+		result.rows = make([]rowInfo, 0, newlines+1)
+	}
+
+	state := newParserStateFor(sr)
+	state.fieldStart = uint64(start)
+	state.lastSepOrNewline = int64(start) - 1
+	currentRowFirstField := 0
+	lineNum := 1
+
+	for chunkIdx := firstChunk; chunkIdx < lastChunk; chunkIdx++ {
+		offset := chunkIdx * simdChunkSize
+		clip := segmentChunkClip(offset, start, len(buf))
+		sepMask := sr.separatorMasks[chunkIdx] & clip
+		nlMask := sr.newlineMasks[chunkIdx] & clip
+		quoteMask := getQuoteMask(sr, chunkIdx) & clip
+
+		processChunkMasks(buf, uint64(offset), sepMask, nlMask, quoteMask, &state, result, &currentRowFirstField, &lineNum)
+	}
+
+	if needsFinalization(buf, &state) {
+		finalizeLastField(buf, &state, result, currentRowFirstField, lineNum)
+	}
+
+	if sr.chunkHasDQ != nil {
+		markFieldsNeedingUnescape(result, sr.chunkHasDQ)
+	}
+
+	return result, lineNum - 1
+}
+
+// segmentChunkClip returns the mask of bit positions of the chunk at offset inside [start, end).
+func segmentChunkClip(offset, start, end int) uint64 {
+	clip := ^uint64(0)
+	if start > offset {
+		clip &^= uint64(1)<<(start-offset) - 1
+	}
+	if end-offset < simdChunkSize {
+		clip &= uint64(1)<<(end-offset) - 1
+	}
+	return clip
+}
@@ -0,0 +1,164 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// =============================================================================
+// Parallel Scan and Parse Tests
+// =============================================================================
+
+// generateRandomStructural returns n bytes drawn mostly from CSV structural characters,
+// so quote pairs, CRLF and separators frequently straddle piece boundaries.
+func generateRandomStructural(rng *rand.Rand, n int) []byte {
+	const alphabet = "\"\"\",,\n\r\nab"
+	buf := make([]byte, n)
+	for i := range buf {
+		buf[i] = alphabet[rng.Intn(len(alphabet))]
+	}
+	return buf
+}
+
+// parallelTestInputs returns inputs exercising piece and segment boundaries.
+func parallelTestInputs() map[string][]byte {
+	rng := rand.New(rand.NewSource(1))
+	inputs := map[string][]byte{
+		"windowed csv":  []byte(generateWindowedCSV(16 * 1024)),
+		"quoted csv":    generateQuotedCSV(500, 5),
+		"escaped csv":   generateEscapedQuotesCSV(500, 5),
+		"single record": []byte(strings.Repeat("x", 1000) + "\n"),
+	}
+	for i := 0; i < 20; i++ {
+		inputs[fmt.Sprintf("random %d", i)] = generateRandomStructural(rng, 1000+rng.Intn(4000))
+	}
+	return inputs
+}
+
+// assertScanResultsEqual compares every observable field of two scan results.
+func assertScanResultsEqual(t *testing.T, got, want *scanResult) {
+	t.Helper()
+	checks := []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"quoteMasks", got.quoteMasks, want.quoteMasks},
+		{"separatorMasks", got.separatorMasks, want.separatorMasks},
+		{"newlineMasks", got.newlineMasks, want.newlineMasks},
+		{"chunkHasDQ", got.chunkHasDQ, want.chunkHasDQ},
+		{"chunkHasQuote", got.chunkHasQuote, want.chunkHasQuote},
+		{"hasQuotes", got.hasQuotes, want.hasQuotes},
+		{"hasCR", got.hasCR, want.hasCR},
+		{"finalQuoted", got.finalQuoted, want.finalQuoted},
+		{"chunkCount", got.chunkCount, want.chunkCount},
+		{"lastChunkBits", got.lastChunkBits, want.lastChunkBits},
+		{"separatorCount", got.separatorCount, want.separatorCount},
+		{"newlineCount", got.newlineCount, want.newlineCount},
+	}
+	for _, c := range checks {
+		if !reflect.DeepEqual(c.got, c.want) {
+			t.Errorf("%s mismatch", c.name)
+		}
+	}
+}
+
+// TestScanBufferParallel_MatchesSerial verifies speculative piece states reproduce the serial scan.
+func TestScanBufferParallel_MatchesSerial(t *testing.T) {
+	for name, input := range parallelTestInputs() {
+		for _, pieceSize := range []int{1, 64, 128, 200, 1024} {
+			t.Run(fmt.Sprintf("%s/piece=%d", name, pieceSize), func(t *testing.T) {
+				want := scanBuffer(input, ',', '"')
+				defer want.release()
+				got := scanBufferParallel(input, ',', '"', TerminatorAny, pieceSize)
+				defer got.release()
+
+				assertScanResultsEqual(t, got, want)
+			})
+		}
+	}
+}
+
+// TestParseBufferParallel_MatchesSerial verifies stitched segments reproduce the serial parse.
+func TestParseBufferParallel_MatchesSerial(t *testing.T) {
+	for name, input := range parallelTestInputs() {
+		for _, segmentSize := range []int{1, 64, 200, 1024} {
+			t.Run(fmt.Sprintf("%s/segment=%d", name, segmentSize), func(t *testing.T) {
+				sr := scanBuffer(input, ',', '"')
+				defer sr.release()
+
+				want := parseBuffer(input, sr)
+				defer want.release()
+				got := parseBufferParallel(input, sr, segmentSize)
+				defer got.release()
+
+				if !reflect.DeepEqual(got.fields, want.fields) {
+					t.Errorf("fields mismatch: got %d, want %d", len(got.fields), len(want.fields))
+				}
+				if !reflect.DeepEqual(got.rows, want.rows) {
+					t.Errorf("rows mismatch: got %d, want %d", len(got.rows), len(want.rows))
+				}
+			})
+		}
+	}
+}
+
+// TestReader_ChunkSize verifies parallel Reader output is identical to serial output.
+func TestReader_ChunkSize(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	input := generateWindowedCSV(2 * defaultBufferSize)
+	serial := NewReader(strings.NewReader(input))
+	serial.FieldsPerRecord = -1
+	want, err := serial.ReadAll()
+	if err != nil {
+		t.Fatalf("serial ReadAll error: %v", err)
+	}
+
+	for _, chunkSize := range []int{100, 4096, 64 * 1024} {
+		t.Run(fmt.Sprintf("chunk=%d", chunkSize), func(t *testing.T) {
+			r := NewReaderWithOptions(strings.NewReader(input), ReaderOptions{ChunkSize: chunkSize})
+			r.FieldsPerRecord = -1
+			got, err := r.ReadAll()
+			if err != nil {
+				t.Fatalf("ReadAll error: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ReadAll mismatch: got %d records, want %d", len(got), len(want))
+			}
+		})
+	}
+}
+
+// TestReader_ChunkSizeMultiByteComma verifies parallel parsing skips the full multi-byte separator.
+func TestReader_ChunkSizeMultiByteComma(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	input := strings.ReplaceAll(generateWindowedCSV(64*1024), ",", "§")
+	serial := NewReader(strings.NewReader(input))
+	serial.Comma = '§'
+	serial.FieldsPerRecord = -1
+	want, err := serial.ReadAll()
+	if err != nil {
+		t.Fatalf("serial ReadAll error: %v", err)
+	}
+	if len(want) == 0 || len(want[0]) != 3 {
+		t.Fatalf("serial ReadAll: got %d records, want 3 fields each", len(want))
+	}
+
+	r := NewReaderWithOptions(strings.NewReader(input), ReaderOptions{ChunkSize: 100})
+	r.Comma = '§'
+	r.FieldsPerRecord = -1
+	got, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadAll mismatch: got %d records, want %d", len(got), len(want))
+	}
+}
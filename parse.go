@@ -3,23 +3,51 @@
 //nolint:gosec // G115: Integer conversions are safe - buffer size bounded by DefaultMaxInputSize (2GB)
 package simdcsv
 
-import "unsafe"
+import (
+	"errors"
+	"iter"
+	"unsafe"
+)
 
 // ============================================================================
 // Public API - Direct Parsing
 // ============================================================================
 
+// ParseOptions configures ParseBytesWithOptions and ParseBytesStreamingWithOptions.
+type ParseOptions struct {
+	// Comma is the field delimiter. It must be set unless Delimiter is set, as with Reader.Comma.
+	Comma rune
+
+	// Delimiter, if not empty, is a multi-character field delimiter that takes
+	// precedence over Comma. See Reader.Delimiter.
+	Delimiter string
+
+	// Quote is the quote character. Zero means '"'.
+	// Must be an ASCII character other than \r, \n and the field delimiter.
+	Quote rune
+}
+
 // ParseBytes parses a byte slice directly (zero-copy).
 // Returns all records extracted from the CSV data.
+// Returns ErrInvalidDelim if comma is not a valid delimiter.
 func ParseBytes(data []byte, comma rune) ([][]string, error) {
+	return ParseBytesWithOptions(data, ParseOptions{Comma: comma})
+}
+
+// ParseBytesWithOptions parses a byte slice directly (zero-copy) using opts.
+// Returns ErrInvalidDelim if the options do not form a valid delimiter configuration.
+func ParseBytesWithOptions(data []byte, opts ParseOptions) ([][]string, error) {
+	d, err := opts.dialect()
+	if err != nil {
+		return nil, err
+	}
 	if len(data) == 0 {
 		return nil, nil
 	}
 
-	separator := byte(comma)
-	sr := scanBuffer(data, separator)
+	sr := scanBufferSeparator(data, d.comma, d.quote)
 	pr := parseBuffer(data, sr)
-	records := buildRecords(data, pr, sr.hasCR)
+	records := buildRecords(data, pr, sr.hasCR, d.quote)
 
 	pr.release()
 	sr.release()
@@ -29,13 +57,22 @@ func ParseBytes(data []byte, comma rune) ([][]string, error) {
 
 // ParseBytesStreaming parses data using a streaming callback function.
 // The callback is invoked for each record. If it returns an error, parsing stops.
+// Returns ErrInvalidDelim if comma is not a valid delimiter.
 func ParseBytesStreaming(data []byte, comma rune, callback func([]string) error) error {
+	return ParseBytesStreamingWithOptions(data, ParseOptions{Comma: comma}, callback)
+}
+
+// ParseBytesStreamingWithOptions is like ParseBytesStreaming but uses opts.
+func ParseBytesStreamingWithOptions(data []byte, opts ParseOptions, callback func([]string) error) error {
+	d, err := opts.dialect()
+	if err != nil {
+		return err
+	}
 	if len(data) == 0 {
 		return nil
 	}
 
-	separator := byte(comma)
-	sr := scanBuffer(data, separator)
+	sr := scanBufferSeparator(data, d.comma, d.quote)
 	pr := parseBuffer(data, sr)
 	defer pr.release()
 	defer sr.release()
@@ -45,7 +82,7 @@ func ParseBytesStreaming(data []byte, comma rune, callback func([]string) error)
 	}
 
 	for _, row := range pr.rows {
-		record := buildRecord(data, pr, row, sr.hasCR)
+		record := buildRecord(data, pr, row, sr.hasCR, d.quote)
 		if err := callback(record); err != nil {
 			return err
 		}
@@ -53,6 +90,42 @@ func ParseBytesStreaming(data []byte, comma rune, callback func([]string) error)
 	return nil
 }
 
+// ParseBytesSeq returns an iterator over the records of data, the iterator
+// analogue of ParseBytesStreaming. An invalid comma yields ErrInvalidDelim once.
+// Breaking out of the loop stops parsing and releases its pooled buffers.
+func ParseBytesSeq(data []byte, comma rune) iter.Seq2[[]string, error] {
+	return func(yield func([]string, error) bool) {
+		err := ParseBytesStreaming(data, comma, func(record []string) error {
+			if !yield(record, nil) {
+				return errStopIteration
+			}
+			return nil
+		})
+		if err != nil && err != errStopIteration {
+			yield(nil, err)
+		}
+	}
+}
+
+// errStopIteration ends ParseBytesStreaming when the consumer of ParseBytesSeq breaks out.
+var errStopIteration = errors.New("stop iteration")
+
+// parseDialect holds the encoded delimiters for the direct parsing API.
+type parseDialect struct {
+	comma []byte
+	quote byte
+}
+
+// dialect validates opts and applies defaults.
+func (opts ParseOptions) dialect() (parseDialect, error) {
+	quote := quoteOrDefault(opts.Quote)
+	comma, ok := separatorBytes(opts.Comma, opts.Delimiter, 0, quote, 0)
+	if !ok {
+		return parseDialect{}, ErrInvalidDelim
+	}
+	return parseDialect{comma: comma, quote: byte(quote)}, nil
+}
+
 // ============================================================================
 // Internal - Record Building (for direct API)
 // ============================================================================
@@ -60,7 +133,7 @@ func ParseBytesStreaming(data []byte, comma rune, callback func([]string) error)
 // buildRecords converts a parseResult to [][]string.
 // Fast path: zero-copy when no transformation needed.
 // Slow path: accumulate into buffer when unescape/CRLF handling required.
-func buildRecords(buf []byte, pr *parseResult, hasCR bool) [][]string {
+func buildRecords(buf []byte, pr *parseResult, hasCR bool, quote byte) [][]string {
 	if pr == nil || len(pr.rows) == 0 {
 		return nil
 	}
@@ -90,7 +163,7 @@ func buildRecords(buf []byte, pr *parseResult, hasCR bool) [][]string {
 	var fieldEnds []int
 	for i, row := range pr.rows {
 		var recordBuf []byte
-		recordBuf, fieldEnds = accumulateFields(buf, pr, row, hasCR, recordBuf, fieldEnds[:0])
+		recordBuf, fieldEnds = accumulateFields(buf, pr, row, hasCR, quote, recordBuf, fieldEnds[:0])
 		records[i] = sliceFieldsFromBuffer(recordBuf, fieldEnds)
 	}
 	return records
@@ -131,20 +204,20 @@ func buildRecordZeroCopy(buf []byte, pr *parseResult, row rowInfo) []string {
 }
 
 // buildRecord builds a single record from a rowInfo (for streaming API).
-func buildRecord(buf []byte, pr *parseResult, row rowInfo, hasCR bool) []string {
-	recordBuf, fieldEnds := accumulateFields(buf, pr, row, hasCR, nil, nil)
+func buildRecord(buf []byte, pr *parseResult, row rowInfo, hasCR bool, quote byte) []string {
+	recordBuf, fieldEnds := accumulateFields(buf, pr, row, hasCR, quote, nil, nil)
 	return sliceFieldsFromBuffer(recordBuf, fieldEnds)
 }
 
 // accumulateFields appends all field contents from a row into recordBuf.
 // Returns the updated recordBuf and fieldEnds slice.
-func accumulateFields(buf []byte, pr *parseResult, row rowInfo, hasCR bool, recordBuf []byte, fieldEnds []int) ([]byte, []int) {
+func accumulateFields(buf []byte, pr *parseResult, row rowInfo, hasCR bool, quote byte, recordBuf []byte, fieldEnds []int) ([]byte, []int) {
 	for i := 0; i < row.fieldCount; i++ {
 		fieldIdx := row.firstField + i
 		if fieldIdx >= len(pr.fields) {
 			break
 		}
-		recordBuf = appendFieldContent(buf, pr.fields[fieldIdx], recordBuf, hasCR)
+		recordBuf = appendFieldContent(buf, pr.fields[fieldIdx], recordBuf, hasCR, quote)
 		fieldEnds = append(fieldEnds, len(recordBuf))
 	}
 	return recordBuf, fieldEnds
@@ -178,18 +251,20 @@ func sliceFieldsFromBuffer(recordBuf []byte, fieldEnds []int) []string {
 
 // appendFieldContent appends field content to buffer with unescape and CRLF normalization.
 // Policy: decides whether transformation is needed based on field metadata and content.
-func appendFieldContent(buf []byte, field fieldInfo, recordBuf []byte, hasCR bool) []byte {
+// crlf reports whether CRs may precede a record terminator, so CRLF in content is normalized;
+// parsing without a Terminator passes the input's hasCR.
+func appendFieldContent(buf []byte, field fieldInfo, recordBuf []byte, crlf bool, quote byte) []byte {
 	content := extractFieldBytes(buf, field)
 	if content == nil {
 		return recordBuf
 	}
 
-	needsTransform := field.needsUnescape() || (hasCR && containsCRLFBytes(content))
+	needsTransform := field.needsUnescape() || (crlf && containsCRLFBytes(content))
 	if !needsTransform {
 		return append(recordBuf, content...)
 	}
 
-	return transformContent(content, recordBuf)
+	return transformContent(content, recordBuf, quote, crlf)
 }
 
 // extractFieldBytes returns the raw bytes for a field, handling bounds checking.
@@ -211,15 +286,15 @@ func extractFieldBytes(buf []byte, field fieldInfo) []byte {
 	return buf[start:end]
 }
 
-// transformContent applies double-quote unescaping and CRLF normalization.
+// transformContent applies doubled-quote unescaping and, if crlf is set, CRLF normalization.
 // Mechanism: pure transformation of bytes without policy decisions.
-func transformContent(content, dst []byte) []byte {
+func transformContent(content, dst []byte, quote byte, crlf bool) []byte {
 	for i := 0; i < len(content); i++ {
 		b := content[i]
-		if b == '"' && i+1 < len(content) && content[i+1] == '"' {
-			dst = append(dst, '"')
+		if b == quote && i+1 < len(content) && content[i+1] == quote {
+			dst = append(dst, quote)
 			i++
-		} else if b == '\r' && i+1 < len(content) && content[i+1] == '\n' {
+		} else if crlf && b == '\r' && i+1 < len(content) && content[i+1] == '\n' {
 			dst = append(dst, '\n')
 			i++
 		} else {
@@ -4,10 +4,12 @@ package simdcsv
 
 import (
 	"encoding/csv"
+	"errors"
 	"io"
 	"reflect"
 	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
 // =============================================================================
@@ -104,6 +106,18 @@ func TestParseBytes_CustomSeparator(t *testing.T) {
 			comma: ';',
 			want:  [][]string{{"a", "b", "c"}},
 		},
+		{
+			name:  "section sign separator",
+			input: "a§©§\"c§d\"\n",
+			comma: '§',
+			want:  [][]string{{"a", "©", "c§d"}},
+		},
+		{
+			name:  "full-width comma separator",
+			input: "a，b\r\nc，\"d\"\"e\"\n",
+			comma: '，',
+			want:  [][]string{{"a", "b"}, {"c", "d\"e"}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -120,6 +134,68 @@ func TestParseBytes_CustomSeparator(t *testing.T) {
 	}
 }
 
+// TestParseBytes_InvalidDelim tests that invalid separators are rejected.
+func TestParseBytes_InvalidDelim(t *testing.T) {
+	for _, comma := range []rune{0, '"', '\n', '\r', utf8.RuneError} {
+		if _, err := ParseBytes([]byte("a,b\n"), comma); !errors.Is(err, ErrInvalidDelim) {
+			t.Errorf("ParseBytes(comma=%q): got %v, want ErrInvalidDelim", comma, err)
+		}
+		err := ParseBytesStreaming([]byte("a,b\n"), comma, func([]string) error { return nil })
+		if !errors.Is(err, ErrInvalidDelim) {
+			t.Errorf("ParseBytesStreaming(comma=%q): got %v, want ErrInvalidDelim", comma, err)
+		}
+	}
+}
+
+// TestParseBytesWithOptions_Quote tests ParseBytesWithOptions with a custom quote character.
+func TestParseBytesWithOptions_Quote(t *testing.T) {
+	opts := ParseOptions{Comma: ';', Quote: '\''}
+	input := []byte("'a;b';'it''s'\r\n\"x\";y\n")
+	want := [][]string{{"a;b", "it's"}, {`"x"`, "y"}}
+
+	got, err := ParseBytesWithOptions(input, opts)
+	if err != nil {
+		t.Fatalf("ParseBytesWithOptions error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseBytesWithOptions = %q, want %q", got, want)
+	}
+
+	var streamed [][]string
+	err = ParseBytesStreamingWithOptions(input, opts, func(record []string) error {
+		streamed = append(streamed, record)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ParseBytesStreamingWithOptions error: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, want) {
+		t.Errorf("ParseBytesStreamingWithOptions = %q, want %q", streamed, want)
+	}
+
+	if _, err := ParseBytesWithOptions(input, ParseOptions{Comma: ',', Quote: ','}); !errors.Is(err, ErrInvalidDelim) {
+		t.Errorf("Quote equal to Comma: got %v, want ErrInvalidDelim", err)
+	}
+}
+
+// TestParseBytesWithOptions_Delimiter tests ParseBytesWithOptions with a multi-character delimiter.
+func TestParseBytesWithOptions_Delimiter(t *testing.T) {
+	input := []byte(strings.Repeat("x", 62) + "||\"a||b\"||c|\n")
+	want := [][]string{{strings.Repeat("x", 62), "a||b", "c|"}}
+
+	got, err := ParseBytesWithOptions(input, ParseOptions{Delimiter: "||"})
+	if err != nil {
+		t.Fatalf("ParseBytesWithOptions error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseBytesWithOptions = %q, want %q", got, want)
+	}
+
+	if _, err := ParseBytesWithOptions(input, ParseOptions{Delimiter: "\"|"}); !errors.Is(err, ErrInvalidDelim) {
+		t.Errorf("Delimiter containing Quote: got %v, want ErrInvalidDelim", err)
+	}
+}
+
 // =============================================================================
 // ParseBytesStreaming Tests
 // =============================================================================
@@ -231,12 +307,78 @@ func TestParseBytesStreaming_CustomSeparator(t *testing.T) {
 	}
 }
 
+// TestParseBytesSeq tests the iterator over ParseBytes records.
+func TestParseBytesSeq(t *testing.T) {
+	input := []byte("a,b\n\"c\"\"\",d\ne,f\n")
+	want, err := ParseBytes(input, ',')
+	if err != nil {
+		t.Fatalf("ParseBytes error: %v", err)
+	}
+
+	var got [][]string
+	for record, err := range ParseBytesSeq(input, ',') {
+		if err != nil {
+			t.Fatalf("ParseBytesSeq error: %v", err)
+		}
+		got = append(got, record)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseBytesSeq = %q, want %q", got, want)
+	}
+
+	t.Run("early break", func(t *testing.T) {
+		count := 0
+		for range ParseBytesSeq(input, ',') {
+			if count++; count == 2 {
+				break
+			}
+		}
+		if count != 2 {
+			t.Errorf("got %d iterations, want 2", count)
+		}
+	})
+
+	t.Run("invalid delimiter", func(t *testing.T) {
+		for _, err := range ParseBytesSeq(input, '\n') {
+			if !errors.Is(err, ErrInvalidDelim) {
+				t.Errorf("got %v, want ErrInvalidDelim", err)
+			}
+		}
+	})
+}
+
+// TestParseBytesRaw verifies ParseBytesRaw matches ParseBytes.
+func TestParseBytesRaw(t *testing.T) {
+	input := []byte(generateWindowedCSV(8192))
+	want, err := ParseBytes(input, ',')
+	if err != nil {
+		t.Fatalf("ParseBytes error: %v", err)
+	}
+
+	got, err := ParseBytesRaw(input, ',')
+	if err != nil {
+		t.Fatalf("ParseBytesRaw error: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d records, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if s := bytesToStrings(got[i]); !reflect.DeepEqual(s, want[i]) {
+			t.Fatalf("record %d: got %q, want %q", i, s, want[i])
+		}
+	}
+
+	if _, err := ParseBytesRaw(input, '"'); !errors.Is(err, ErrInvalidDelim) {
+		t.Errorf("invalid comma: got %v, want ErrInvalidDelim", err)
+	}
+}
+
 // =============================================================================
 // buildRecords Tests
 // =============================================================================
 
 func TestBuildRecords_Nil(t *testing.T) {
-	result := buildRecords(nil, nil, false)
+	result := buildRecords(nil, nil, false, '"')
 	if result != nil {
 		t.Errorf("buildRecords(nil, nil) = %v, want nil", result)
 	}
@@ -247,7 +389,7 @@ func TestBuildRecords_EmptyRows(t *testing.T) {
 		fields: []fieldInfo{},
 		rows:   []rowInfo{},
 	}
-	result := buildRecords([]byte(""), pr, false)
+	result := buildRecords([]byte(""), pr, false, '"')
 	if result != nil {
 		t.Errorf("buildRecords with empty rows = %v, want nil", result)
 	}
@@ -269,7 +411,7 @@ func TestBuildRecord(t *testing.T) {
 		},
 	}
 
-	record := buildRecord(buf, pr, pr.rows[0], false)
+	record := buildRecord(buf, pr, pr.rows[0], false, '"')
 
 	if len(record) != 2 {
 		t.Fatalf("expected 2 fields, got %d", len(record))
@@ -28,24 +28,39 @@ func skipLeadingWhitespace(data []byte) int {
 	return len(data)
 }
 
+// =============================================================================
+// Quote Character
+// =============================================================================
+
+// defaultQuote is the quote character used when Reader.Quote or Writer.Quote is zero.
+const defaultQuote = '"'
+
+// quoteOrDefault returns q, or defaultQuote if q is zero.
+func quoteOrDefault(q rune) rune {
+	if q == 0 {
+		return defaultQuote
+	}
+	return q
+}
+
 // =============================================================================
 // Quote Detection
 // =============================================================================
 
-// isQuotedFieldStart checks if data starts with a quote, optionally after whitespace.
+// isQuotedFieldStart checks if data starts with quote, optionally after whitespace.
 // Returns (isQuoted, quoteOffset) where quoteOffset is the position of the opening quote.
-func isQuotedFieldStart(data []byte, trimLeadingSpace bool) (bool, int) {
+func isQuotedFieldStart(data []byte, trimLeadingSpace bool, quote byte) (bool, int) {
 	if len(data) == 0 {
 		return false, 0
 	}
 
-	if data[0] == '"' {
+	if data[0] == quote {
 		return true, 0
 	}
 
 	if trimLeadingSpace {
 		offset := skipLeadingWhitespace(data)
-		if offset > 0 && offset < len(data) && data[offset] == '"' {
+		if offset > 0 && offset < len(data) && data[offset] == quote {
 			return true, offset
 		}
 	}
@@ -54,8 +69,8 @@ func isQuotedFieldStart(data []byte, trimLeadingSpace bool) (bool, int) {
 }
 
 // isEscapedQuote checks if the quote at position i is escaped (followed by another quote).
-func isEscapedQuote(data []byte, i int) bool {
-	return i+1 < len(data) && data[i+1] == '"'
+func isEscapedQuote(data []byte, i int, quote byte) bool {
+	return i+1 < len(data) && data[i+1] == quote
 }
 
 // =============================================================================
@@ -64,13 +79,13 @@ func isEscapedQuote(data []byte, i int) bool {
 
 // findClosingQuote finds the closing quote in a quoted field.
 // Returns the index of the closing quote, or -1 if not found.
-// Handles escaped double quotes ("").
-func findClosingQuote(data []byte, startAfterOpenQuote int) int {
+// Handles escaped double quotes ("" for the default quote character).
+func findClosingQuote(data []byte, startAfterOpenQuote int, quote byte) int {
 	remaining := len(data) - startAfterOpenQuote
 	if shouldUseSIMD(remaining) {
-		return findClosingQuoteSIMD(data, startAfterOpenQuote)
+		return findClosingQuoteSIMD(data, startAfterOpenQuote, quote)
 	}
-	return findClosingQuoteScalar(data, startAfterOpenQuote)
+	return findClosingQuoteScalar(data, startAfterOpenQuote, quote)
 }
 
 // =============================================================================
@@ -78,14 +93,14 @@ func findClosingQuote(data []byte, startAfterOpenQuote int) int {
 // =============================================================================
 
 // findClosingQuoteScalar finds the closing quote using scalar operations.
-func findClosingQuoteScalar(data []byte, startAfterOpenQuote int) int {
+func findClosingQuoteScalar(data []byte, startAfterOpenQuote int, quote byte) int {
 	for i := startAfterOpenQuote; i < len(data); {
-		next := bytes.IndexByte(data[i:], '"')
+		next := bytes.IndexByte(data[i:], quote)
 		if next == -1 {
 			return -1
 		}
 		pos := i + next
-		if isEscapedQuote(data, pos) {
+		if isEscapedQuote(data, pos, quote) {
 			i = pos + 2
 			continue
 		}
@@ -99,20 +114,21 @@ func findClosingQuoteScalar(data []byte, startAfterOpenQuote int) int {
 // =============================================================================
 
 // findClosingQuoteSIMD uses AVX-512 to find the closing quote in 64-byte chunks.
-func findClosingQuoteSIMD(data []byte, startAfterOpenQuote int) int {
+func findClosingQuoteSIMD(data []byte, startAfterOpenQuote int, quote byte) int {
 	int8Data := bytesToInt8Slice(data)
+	quoteCmp := cachedSepCmp[quote]
 	i := startAfterOpenQuote
 
 	for i+simdChunkSize <= len(data) {
 		chunk := archsimd.LoadInt8x64Slice(int8Data[i : i+simdChunkSize])
-		mask := chunk.Equal(cachedQuoteCmp).ToBits()
+		mask := chunk.Equal(quoteCmp).ToBits()
 
 		if mask == 0 {
 			i += simdChunkSize
 			continue
 		}
 
-		result, newI, done := processQuoteMask(data, i, mask)
+		result, newI, done := processQuoteMask(data, i, mask, quote)
 		if result >= 0 {
 			return result
 		}
@@ -122,18 +138,18 @@ func findClosingQuoteSIMD(data []byte, startAfterOpenQuote int) int {
 		i = newI
 	}
 
-	return findClosingQuoteScalar(data, i)
+	return findClosingQuoteScalar(data, i, quote)
 }
 
 // processQuoteMask processes quote positions in a SIMD chunk mask.
 // Returns (closingQuoteIdx, newPosition, shouldExitLoop).
-func processQuoteMask(data []byte, chunkStart int, mask uint64) (int, int, bool) {
+func processQuoteMask(data []byte, chunkStart int, mask uint64, quote byte) (int, int, bool) {
 	// Fast path: no adjacent quotes in this chunk, so first quote closes.
 	if mask&(mask<<1) == 0 {
 		pos := bits.TrailingZeros64(mask)
 		if pos == simdChunkSize-1 {
 			newPos := chunkStart + simdChunkSize
-			if newPos < len(data) && data[newPos] == '"' {
+			if newPos < len(data) && data[newPos] == quote {
 				// Boundary double quote → skip both
 				return -1, newPos + 1, false
 			}
@@ -149,7 +165,7 @@ func processQuoteMask(data []byte, chunkStart int, mask uint64) (int, int, bool)
 		// Boundary case: quote at last position of chunk
 		if pos == simdChunkSize-1 {
 			newPos := chunkStart + simdChunkSize
-			if newPos < len(data) && data[newPos] == '"' {
+			if newPos < len(data) && data[newPos] == quote {
 				// Boundary double quote → skip both
 				return -1, newPos + 1, false
 			}
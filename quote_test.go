@@ -129,7 +129,7 @@ func TestIsQuotedFieldStart(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotIsQuoted, gotOffset := isQuotedFieldStart(tt.input, tt.trimLeadingSpace)
+			gotIsQuoted, gotOffset := isQuotedFieldStart(tt.input, tt.trimLeadingSpace, '"')
 			if gotIsQuoted != tt.wantIsQuoted || gotOffset != tt.wantOffset {
 				t.Errorf("isQuotedFieldStart(%q, %v) = (%v, %d), want (%v, %d)",
 					tt.input, tt.trimLeadingSpace, gotIsQuoted, gotOffset, tt.wantIsQuoted, tt.wantOffset)
@@ -189,7 +189,7 @@ func TestFindClosingQuote(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := findClosingQuote(tt.input, tt.start)
+			got := findClosingQuote(tt.input, tt.start, '"')
 			if got != tt.want {
 				t.Errorf("findClosingQuote(%q, %d) = %d, want %d", tt.input, tt.start, got, tt.want)
 			}
@@ -245,9 +245,9 @@ func TestFindClosingQuote_SIMDvsScalar(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			scalar := findClosingQuoteScalar(tt.input, tt.start)
+			scalar := findClosingQuoteScalar(tt.input, tt.start, '"')
 			// Test SIMD version directly (even for small inputs)
-			simd := findClosingQuoteSIMD(tt.input, tt.start)
+			simd := findClosingQuoteSIMD(tt.input, tt.start, '"')
 			if scalar != simd {
 				t.Errorf("findClosingQuote mismatch for %q: scalar=%d, simd=%d",
 					tt.input, scalar, simd)
@@ -304,15 +304,15 @@ func TestFindClosingQuote_LargeInput(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := findClosingQuote(tt.input, tt.start)
+			got := findClosingQuote(tt.input, tt.start, '"')
 			if got != tt.want {
 				t.Errorf("findClosingQuote(%q..., %d) = %d, want %d",
 					string(tt.input[:min(30, len(tt.input))]), tt.start, got, tt.want)
 			}
 			// Also verify scalar and SIMD match (only if AVX-512 available)
-			scalar := findClosingQuoteScalar(tt.input, tt.start)
+			scalar := findClosingQuoteScalar(tt.input, tt.start, '"')
 			if useAVX512 {
-				simd := findClosingQuoteSIMD(tt.input, tt.start)
+				simd := findClosingQuoteSIMD(tt.input, tt.start, '"')
 				if scalar != simd {
 					t.Errorf("scalar/simd mismatch: scalar=%d, simd=%d", scalar, simd)
 				}
@@ -0,0 +1,226 @@
+//go:build goexperiment.simd && amd64
+
+//nolint:gosec // G115: Integer conversions are safe - positions bounded by the window length
+package simdcsv
+
+import (
+	"fmt"
+	"io"
+	"slices"
+)
+
+// =============================================================================
+// Random Access
+// =============================================================================
+//
+// The first call to Len, RecordAt, Seek or Rewind reads the rest of the input
+// into the current window and parses it as one, so the window's rows index
+// every record. The window is then never retired: reaching the end of the
+// records returns io.EOF, and Seek moves the read position back over the same
+// scan. Memory is bounded by MaxInputSize, DefaultMaxInputSize unless set,
+// rather than BufferSize.
+//
+// Records are numbered from 0 in input order, excluding the header and comment
+// lines, which are never returned by position. Validation is the same as for
+// Read, whatever order records are accessed in: quotes are validated when a
+// record is built, and with FieldsPerRecord 0 the expected field count is
+// fixed by the first record of the input (or the header) when the index is
+// built, not by the first record accessed.
+//
+// =============================================================================
+
+// Len returns the number of records in the input, excluding the header and
+// comment lines. It reads the rest of the input into memory on first use,
+// without moving the read position. Malformed records are counted, so under
+// ReaderOptions.OnError Read may return fewer than Len records.
+//
+// Random access needs every record still buffered: Len returns
+// ErrRecordsReleased if a window holding records has already been released by
+// reading, or a record was dropped by resynchronizing after a stray quote.
+// Call Len before the first read to index the whole input.
+func (r *Reader) Len() (int, error) {
+	if err := r.buildIndex(); err != nil {
+		return 0, err
+	}
+	return len(r.state.recordIndex), nil
+}
+
+// RecordAt returns record i, 0-indexed as for Len, without moving the read
+// position. The record is built and validated as Read would build it,
+// including ReuseRecord and ZeroCopy, and the OnError policy does not apply:
+// a malformed record is returned with its error. FieldPos, RecordOffset and
+// RawRecord describe record i until the next read.
+func (r *Reader) RecordAt(i int) ([]string, error) {
+	if err := r.buildIndex(); err != nil {
+		return nil, err
+	}
+	if i < 0 || i >= len(r.state.recordIndex) {
+		return nil, fmt.Errorf("simdcsv: record index %d out of range [0, %d)", i, len(r.state.recordIndex))
+	}
+
+	pos, count, offset := r.state.currentRecordIndex, r.state.recordCount, r.state.inputOffset
+	r.seekRecord(i)
+	record, err := r.readRecord()
+	r.state.currentRecordIndex, r.state.recordCount, r.state.inputOffset = pos, count, offset
+	return record, err
+}
+
+// Seek sets the read position so that the next read returns record i,
+// 0-indexed as for Len; Seek(Len()) positions at the end. All read methods
+// continue from there, and InputOffset becomes the offset of record i.
+// Records dropped under OnError are dropped again on each pass.
+func (r *Reader) Seek(i int) error {
+	if err := r.buildIndex(); err != nil {
+		return err
+	}
+	if i < 0 || i > len(r.state.recordIndex) {
+		return fmt.Errorf("simdcsv: record index %d out of range [0, %d]", i, len(r.state.recordIndex))
+	}
+	r.seekRecord(i)
+	return nil
+}
+
+// Rewind sets the read position back to the first record, after the header.
+// It is Seek(0).
+func (r *Reader) Rewind() error {
+	return r.Seek(0)
+}
+
+// seekRecord moves the read position to record i of the index, or past the
+// last record if i is the index length.
+func (r *Reader) seekRecord(i int) {
+	index := r.state.recordIndex
+	r.state.recordCount = int64(i)
+	if r.opts.header {
+		r.state.recordCount++
+	}
+	if i == len(index) {
+		r.state.currentRecordIndex = len(r.windowRows())
+		r.state.inputOffset = r.inputOffset(uint64(len(r.state.rawBuffer)))
+		return
+	}
+
+	row := r.state.parseResult.rows[index[i]]
+	r.state.currentRecordIndex = index[i]
+	r.state.inputOffset = r.inputOffset(uint64(r.state.parseResult.fields[row.firstField].rawStart()))
+}
+
+// buildIndex buffers the rest of the input into the current window and
+// indexes its records, once per input.
+func (r *Reader) buildIndex() error {
+	if err := r.ensureInitialized(); err != nil {
+		return err
+	}
+	if r.state.indexed {
+		return nil
+	}
+	if r.state.inputErr != nil {
+		return r.state.inputErr
+	}
+
+	// Records read from the current window, which is parsed again below
+	read := 0
+	for i := range r.state.currentRecordIndex {
+		if !r.isCommentLine(r.state.parseResult.rows[i], i) {
+			read++
+		}
+	}
+	released := r.state.recordCount - int64(read)
+	headerHere := r.opts.header && released == 0
+	if r.opts.header && !headerHere {
+		released-- // the header need not stay buffered
+	}
+	if released > 0 || r.state.resynced {
+		return ErrRecordsReleased
+	}
+
+	buf, err := r.readRemaining()
+	if err != nil {
+		r.state.inputErr = err
+		return err
+	}
+	buf = r.skipUTF8BOM(buf)
+	r.state.window = buf
+	r.releasePooled()
+	r.state.indexed = true
+	if len(buf) > 0 {
+		r.loadWindow(buf, 0, len(buf), r.scanWindow(buf))
+	}
+
+	rows := r.windowRows()
+	index := make([]int, 0, len(rows))
+	for i, row := range rows {
+		if !r.isCommentLine(row, i) {
+			index = append(index, i)
+		}
+	}
+	if headerHere && len(index) > 0 {
+		index = index[1:]
+	}
+	r.state.recordIndex = index
+
+	if r.FieldsPerRecord == 0 && r.isFirstNonCommentRecord() && len(index) > 0 {
+		r.FieldsPerRecord = rows[index[0]].fieldCount
+	}
+	return nil
+}
+
+// windowRows returns the parsed rows of the current window, if any.
+func (r *Reader) windowRows() []rowInfo {
+	if r.state.parseResult == nil {
+		return nil
+	}
+	return r.state.parseResult.rows
+}
+
+// readRemaining returns the bytes of the current window and its pending tail
+// followed by the rest of the source.
+func (r *Reader) readRemaining() ([]byte, error) {
+	limit := r.maxInputSize(true)
+	size := len(r.state.rawBuffer) + len(r.state.pending)
+	if r.state.sourceSize >= 0 {
+		remaining := max(r.state.sourceSize-r.state.bytesRead, 0)
+		if limit > 0 {
+			remaining = min(remaining, limit)
+		}
+		// One extra byte lets the read observe io.EOF without growing
+		size += int(remaining) + 1
+	}
+	buf := make([]byte, 0, max(size, r.bufferSize()))
+	buf = append(buf, r.state.rawBuffer...)
+	buf = append(buf, r.state.pending...)
+
+	emptyReads := 0
+	for !r.state.sourceEOF && !r.exceedsMaxInputSize(true) {
+		if len(buf) == cap(buf) {
+			buf = slices.Grow(buf, len(buf))
+		}
+		end := cap(buf)
+		if limit > 0 {
+			// Read at most one byte past the limit
+			end = min(end, len(buf)+int(limit+1-r.state.bytesRead))
+		}
+		n, err := r.source.Read(buf[len(buf):end])
+		buf = buf[:len(buf)+n]
+		r.state.bytesRead += int64(n)
+
+		if err == io.EOF {
+			r.state.sourceEOF = true
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if n > 0 {
+			emptyReads = 0
+		} else if emptyReads++; emptyReads >= maxConsecutiveEmptyReads {
+			return nil, io.ErrNoProgress
+		}
+	}
+
+	if r.exceedsMaxInputSize(true) {
+		return nil, ErrInputTooLarge
+	}
+	return buf, nil
+}
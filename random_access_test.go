@@ -0,0 +1,156 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// =============================================================================
+// Random Access Tests
+// =============================================================================
+
+// TestRandomAccess_Passes verifies Rewind repeats the records of the first pass across windows.
+func TestRandomAccess_Passes(t *testing.T) {
+	input := generateWindowedCSV(4 * 4096)
+	want, err := NewReader(strings.NewReader(input)).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll error: %v", err)
+	}
+
+	for _, opts := range []ReaderOptions{{BufferSize: 4096}, {BufferSize: 4096, ZeroCopy: true}} {
+		r := NewReaderWithOptions(strings.NewReader(input), opts)
+		// Index after reading a few records of the first window
+		for range 3 {
+			if _, err := r.Read(); err != nil {
+				t.Fatalf("Read error: %v", err)
+			}
+		}
+		n, err := r.Len()
+		if err != nil || n != len(want) {
+			t.Fatalf("%+v: Len() = %d, %v, want %d", opts, n, err, len(want))
+		}
+
+		for pass := range 2 {
+			if err := r.Rewind(); err != nil {
+				t.Fatalf("Rewind error: %v", err)
+			}
+			got, err := r.ReadAll()
+			if err != nil {
+				t.Fatalf("%+v pass %d: ReadAll error: %v", opts, pass, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%+v pass %d: got %d records, want %d", opts, pass, len(got), len(want))
+			}
+			if got := r.InputOffset(); got != int64(len(input)) {
+				t.Errorf("%+v pass %d: InputOffset() at end = %d, want %d", opts, pass, got, len(input))
+			}
+		}
+	}
+}
+
+// TestRandomAccess_Positions tests RecordAt and Seek with a header, comments and CRLF.
+func TestRandomAccess_Positions(t *testing.T) {
+	input := "id,name\r\n# comment\r\n1,a\r\n2,b\r\n# another\r\n3,c\r\n"
+	r := NewReaderWithOptions(strings.NewReader(input), ReaderOptions{Header: true})
+	r.Comment = '#'
+
+	if n, err := r.Len(); err != nil || n != 3 {
+		t.Fatalf("Len() = %d, %v, want 3", n, err)
+	}
+	for _, i := range []int{2, 0, 1} {
+		record, err := r.RecordAt(i)
+		if err != nil {
+			t.Fatalf("RecordAt(%d) error: %v", i, err)
+		}
+		if want := []string{string(rune('1' + i)), string(rune('a' + i))}; !reflect.DeepEqual(record, want) {
+			t.Errorf("RecordAt(%d) = %q, want %q", i, record, want)
+		}
+	}
+
+	// RecordAt leaves the read position at the first record
+	if record, err := r.Read(); err != nil || record[0] != "1" {
+		t.Errorf("Read after RecordAt = %q, %v, want record 1", record, err)
+	}
+	if err := r.Seek(2); err != nil {
+		t.Fatalf("Seek error: %v", err)
+	}
+	if got, want := r.InputOffset(), int64(strings.Index(input, "3,c")); got != want {
+		t.Errorf("InputOffset() after Seek(2) = %d, want %d", got, want)
+	}
+	if record, err := r.Read(); err != nil || record[0] != "3" {
+		t.Errorf("Read after Seek(2) = %q, %v, want record 3", record, err)
+	}
+	if line, _ := r.FieldPos(0); line != 6 {
+		t.Errorf("FieldPos(0) line = %d, want 6", line)
+	}
+
+	if err := r.Seek(3); err != nil {
+		t.Fatalf("Seek(Len()) error: %v", err)
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("Read after Seek(Len()) = %v, want io.EOF", err)
+	}
+	if err := r.Seek(4); err == nil {
+		t.Error("Seek(4) succeeded, want out of range error")
+	}
+	if _, err := r.RecordAt(3); err == nil {
+		t.Error("RecordAt(3) succeeded, want out of range error")
+	}
+}
+
+// TestRandomAccess_Validation tests that field counts and errors do not depend on access order.
+func TestRandomAccess_Validation(t *testing.T) {
+	r := NewReader(strings.NewReader("a,b\nc\nd,\"e\"x\nf,g\n"))
+
+	_, err := r.RecordAt(1)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || !errors.Is(err, ErrFieldCount) || parseErr.Record != 2 {
+		t.Errorf("RecordAt(1) error = %v, want ErrFieldCount in record 2", err)
+	}
+	if record, err := r.RecordAt(3); err != nil || record[0] != "f" {
+		t.Errorf("RecordAt(3) = %q, %v, want record f", record, err)
+	}
+	if _, err := r.RecordAt(2); !errors.Is(err, ErrQuote) {
+		t.Errorf("RecordAt(2) error = %v, want ErrQuote", err)
+	}
+
+	// Malformed records are dropped again on each pass
+	r = NewReaderWithOptions(strings.NewReader("a,b\nc\nd,\"e\"x\nf,g\n"), ReaderOptions{OnError: OnErrorSkip})
+	for pass := range 2 {
+		if err := r.Rewind(); err != nil {
+			t.Fatalf("Rewind error: %v", err)
+		}
+		got, err := r.ReadAll()
+		if want := [][]string{{"a", "b"}, {"f", "g"}}; err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("pass %d: ReadAll = %q, %v, want %q", pass, got, err, want)
+		}
+	}
+	if n := len(r.SkippedErrors()); n != 4 {
+		t.Errorf("SkippedErrors has %d entries, want 4", n)
+	}
+}
+
+// TestRandomAccess_Released tests indexing after records were released by reading.
+func TestRandomAccess_Released(t *testing.T) {
+	input := generateWindowedCSV(3 * 4096)
+	r := NewReaderWithOptions(strings.NewReader(input), ReaderOptions{BufferSize: 4096})
+	if _, err := r.ReadAll(); err != nil {
+		t.Fatalf("ReadAll error: %v", err)
+	}
+	if _, err := r.Len(); !errors.Is(err, ErrRecordsReleased) {
+		t.Errorf("Len() after ReadAll error = %v, want ErrRecordsReleased", err)
+	}
+
+	r.Reset(strings.NewReader(""))
+	if n, err := r.Len(); err != nil || n != 0 {
+		t.Errorf("Len() of empty input = %d, %v, want 0", n, err)
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("Read of empty input = %v, want io.EOF", err)
+	}
+}
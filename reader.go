@@ -4,7 +4,14 @@
 // It is API-compatible with the standard library's encoding/csv package.
 package simdcsv
 
-import "io"
+import (
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"strings"
+	"unicode/utf8"
+)
 
 // ============================================================================
 // Public Types
@@ -19,7 +26,7 @@ import "io"
 // # Configuration (Policy)
 //
 // Public fields control parsing behavior:
-//   - Comma, Comment: delimiter configuration
+//   - Comma, Delimiter, Comment, Quote, Escape, Terminator: delimiter configuration
 //   - FieldsPerRecord: field count validation mode
 //   - LazyQuotes, TrimLeadingSpace: quote and whitespace handling
 //   - ReuseRecord: memory allocation strategy
@@ -27,12 +34,23 @@ import "io"
 // # Implementation (Mechanism)
 //
 // Internal state handles the actual parsing using SIMD-accelerated scanning
-// and field extraction.
+// and field extraction. Input is read in bounded windows of complete records,
+// so memory use does not grow with the size of the input.
 type Reader struct {
 	// Comma is the field delimiter (set to ',' by NewReader).
 	// Must be a valid rune and must not be \r, \n, or the Unicode replacement character (0xFFFD).
+	// Multi-byte runes such as '§' or '，' are matched as their full UTF-8 sequence.
+	// Read returns ErrInvalidDelim if Comma or Comment is invalid.
 	Comma rune
 
+	// Delimiter, if not empty, is a multi-character field delimiter such as
+	// "||" or "~|~". It takes precedence over Comma.
+	// Must be valid UTF-8 of at most 64 bytes, and must not contain \r, \n,
+	// NUL, Quote, Comment or Escape.
+	// Overlapping occurrences match leftmost-first: with "||", "a|||b" splits
+	// into "a" and "|b".
+	Delimiter string
+
 	// Comment, if not 0, is the comment character.
 	// Lines beginning with Comment (without preceding whitespace) are ignored.
 	// With leading whitespace, the Comment character becomes part of the field,
@@ -40,6 +58,31 @@ type Reader struct {
 	// Must be a valid rune, not \r, \n, 0xFFFD, and not equal to Comma.
 	Comment rune
 
+	// Quote is the character that encloses quoted fields (set to '"' by NewReader).
+	// Inside a quoted field, a doubled Quote stands for one literal Quote.
+	// Must be an ASCII character other than \r, \n, Comma and Comment.
+	// Zero is treated as '"'.
+	Quote rune
+
+	// Escape, if not 0, enables the backslash-escape dialect written by MySQL
+	// and Hive, typically with Escape set to '\\'. The byte following Escape is
+	// taken literally: it does not open or close a quoted field, split fields or
+	// end the record. \n, \r and \t decode to LF, CR and tab, any other escaped
+	// byte decodes to itself, and a field consisting of exactly \N (NULL)
+	// decodes to the empty string; Row.IsNull and the Validity bitmap of
+	// ReadBatch tell NULL from an empty field.
+	// Doubled quotes are still accepted.
+	// Must be an ASCII character other than \r, \n, Comma, Comment and Quote.
+	// Escaping disables parallel scanning for ReaderOptions.ChunkSize.
+	Escape rune
+
+	// Terminator selects what ends a record: LF, CRLF or a lone CR by default,
+	// or exactly one of TerminatorLF, TerminatorCRLF and TerminatorCR.
+	// TerminatorByte(0x1E) ends records at a custom byte, here ASCII RS; the
+	// byte must not be NUL, Quote, Comment, Escape or part of the delimiter.
+	// Line breaks that do not end a record are ordinary field data.
+	Terminator Terminator
+
 	// FieldsPerRecord is the number of expected fields per record.
 	//   - Positive: Read requires each record to have exactly this many fields.
 	//   - Zero: Read sets it to the first record's field count; subsequent records must match.
@@ -75,20 +118,88 @@ type ReaderOptions struct {
 	// SkipBOM removes UTF-8 BOM (EF BB BF) from the beginning of input if present.
 	SkipBOM bool
 
-	// MaxInputSize is the maximum allowed input size in bytes.
-	//   - 0: Use DefaultMaxInputSize (2GB)
+	// MaxInputSize is the maximum number of bytes read from the source.
+	// Input is read incrementally, so records before the limit may be returned
+	// before Read reports ErrInputTooLarge.
+	//   - 0: No limit for Read and the other streaming reads, whose memory is
+	//     bounded by BufferSize and MaxBufferSize; DefaultMaxInputSize (2GB)
+	//     for ReadAll and random access, which hold the whole input in memory
 	//   - -1: Unlimited (not recommended for untrusted input)
 	//   - >0: Custom limit
 	MaxInputSize int64
 
-	// BufferSize is the internal buffer size hint (not yet implemented).
+	// BufferSize is the number of bytes read from the source per window.
+	// It also bounds the per-window scan and parse results, capping per-Reader memory.
+	// Records longer than BufferSize grow the window on demand (see MaxBufferSize).
+	//   - 0: Use the default (256KB)
+	//   - >0: Custom window size
 	BufferSize int
 
-	// ChunkSize is the parallel processing chunk size (not yet implemented).
+	// MaxBufferSize is the maximum size a window may grow to when holding a
+	// single record longer than BufferSize. Read returns ErrRecordTooLarge
+	// when a record does not fit.
+	//   - 0: Use DefaultMaxInputSize (2GB)
+	//   - >0: Custom limit
+	MaxBufferSize int
+
+	// ChunkSize splits each window into pieces of this many bytes that are
+	// scanned and parsed concurrently. Records are identical to serial parsing.
+	// Set BufferSize to a multiple of ChunkSize large enough to keep all cores busy.
+	//   - 0: Serial processing
+	//   - >0: Piece size, rounded up to a multiple of 64 bytes
 	ChunkSize int
 
-	// ZeroCopy enables zero-copy optimization (not yet implemented).
+	// ZeroCopy makes Read return strings that alias the Reader's input buffer.
+	// Fields that need no unescaping or CRLF normalization are never copied;
+	// transformed fields are written to a scratch buffer owned by the Reader.
+	// Both buffers are recycled across windows, so Read allocates nothing
+	// per record when combined with ReuseRecord.
+	//
+	// Lifetime: strings returned by Read are valid only until the next call to
+	// Read. Use strings.Clone to retain a value. Records returned by ReadAll
+	// remain valid because ReadAll does not recycle buffers, until Reset or
+	// Release hands the buffers to a new source.
 	ZeroCopy bool
+
+	// Header reads the first record, after any comment lines, as column names.
+	// The header is not returned as a record; see Reader.Header, ReadMap and
+	// ColumnIndex. It counts as the first record for FieldsPerRecord.
+	Header bool
+
+	// HeaderCaseInsensitive matches column names regardless of case.
+	HeaderCaseInsensitive bool
+
+	// HeaderTrimSpace removes leading and trailing white space from column names.
+	HeaderTrimSpace bool
+
+	// HeaderDuplicates selects how repeated column names are handled
+	// (default: DuplicateHeaderError).
+	HeaderDuplicates DuplicateHeader
+
+	// OnError selects what happens to a record with a quote error or the
+	// wrong number of fields (default: OnErrorFail). Read errors, header
+	// errors and cancellation always fail. Dropped records are listed by
+	// Reader.SkippedErrors.
+	OnError ErrorPolicy
+
+	// ErrorHandler is called with the error of each malformed record under
+	// OnErrorCallback. Returning nil drops the record; any other error is
+	// returned by the read call and the record is consumed.
+	ErrorHandler func(err *ParseError) error
+
+	// MaxErrors is the number of malformed records that may be dropped.
+	// Beyond it, the read call returns an error wrapping ErrTooManyErrors and
+	// the record's ParseError, and so does every later read until Reset.
+	//   - 0: No limit
+	//   - >0: Custom limit
+	MaxErrors int
+
+	// Quarantine, if set, receives each record dropped under OnError exactly
+	// as it appears in the input, including embedded line breaks and its
+	// terminator, so that it can be fixed and replayed. SkippedErrors lists
+	// the errors of the records in the same order. A write error fails the
+	// read call.
+	Quarantine io.Writer
 }
 
 // ============================================================================
@@ -99,14 +210,69 @@ type ReaderOptions struct {
 // Separating state from configuration makes the Reader easier to understand.
 type readerState struct {
 	// Input state
-	offset    int64
-	rawBuffer []byte
+	rawBuffer   []byte // complete records of the current window
+	inputOffset int64  // end of the last record, or of the input after io.EOF
+	recordStart int64  // input range of the last record
+	recordEnd   int64
+
+	// Streaming window state
+	pending    []byte // bytes after the last complete record, carried to the next window
+	windowBase int64  // absolute input offset of rawBuffer[0]
+	lineBase   int    // lines consumed by previous windows
+	lineCount  int    // lines consumed by the current window
+	bytesRead  int64  // total bytes read from source
+	sourceSize int64  // total source size if known, -1 otherwise
+	sourceEOF  bool   // source has returned io.EOF
+	inputErr   error  // sticky error from reading the source or exceeding MaxErrors
+	window     []byte // backing buffer of the current window, recycled for the next one
+
+	// Cancellation state, installed by ReadContext and ReadAllContext
+	ctx     context.Context
+	ctxDone <-chan struct{} // ctx.Done(), nil when no context is installed
+
+	// Delimiter encodings, set at initialization
+	comma   []byte // Delimiter, or the UTF-8 encoding of Comma
+	comment []byte // UTF-8 encoding of Comment, nil if unset
+
+	// ZeroCopy state
+	fieldArena    []byte // transformed field contents for the current window
+	retainWindows bool   // disable window recycling (records must outlive the next Read)
+
+	// ReadAll state: records share their allocations
+	windowCopy string   // copy of rawBuffer holding the fields of its records
+	recordText []byte   // unused part of the allocation window copies are carved from
+	recordSlab []string // unused part of the allocation records are carved from
 
 	// Field position tracking for FieldPos()
 	fieldPositions []position
 
 	// Record reuse for ReuseRecord option
-	lastRecord []string
+	lastRecord      []string
+	lastBytesRecord [][]byte
+
+	// Lazy record returned by ReadRow
+	row Row
+
+	// Batch reused by ReadBatch with ReuseRecord
+	lastBatch *Batch
+
+	// Header mode state
+	header      []string
+	headerIndex map[string]int // lookup key to column index
+	mapColumns  []int          // columns included by ReadMap
+	headerRead  bool
+	headerErr   error // sticky error from reading the header
+
+	// Errors of records dropped under ReaderOptions.OnError
+	skippedErrors []*ParseError
+	resynced      bool // a window was cut short after a stray quote
+
+	// Random access state: the window holds the whole input
+	indexed     bool
+	recordIndex []int // rows of the data records, by record number
+
+	// Column indexes resolved by BindRow
+	columnBindings []columnBinding
 
 	// Batch string allocation buffers
 	recordBuffer []byte
@@ -117,23 +283,34 @@ type readerState struct {
 	parseResult           *parseResult
 	currentRecordIndex    int
 	nonCommentRecordCount int
+	recordCount           int64 // records returned by nextRow, for ParseError.Record
 	initialized           bool
 
 	// Fast path flags from SIMD scan
 	hasQuotes     bool
 	hasCR         bool
+	hasEscapes    bool
 	chunkHasQuote []bool
 }
 
 // extendedOptions holds configuration beyond the standard encoding/csv API.
 type extendedOptions struct {
-	skipBOM      bool
-	maxInputSize int64
-
-	// Reserved for future streaming/chunked processing
-	bufferSize int
-	chunkSize  int
-	zeroCopy   bool
+	skipBOM       bool
+	maxInputSize  int64
+	bufferSize    int
+	maxBufferSize int
+	chunkSize     int
+	zeroCopy      bool
+
+	header           bool
+	headerFold       bool
+	headerTrimSpace  bool
+	headerDuplicates DuplicateHeader
+
+	onError      ErrorPolicy
+	errorHandler func(err *ParseError) error
+	maxErrors    int
+	quarantine   io.Writer
 }
 
 // position represents a position in the input.
@@ -150,6 +327,7 @@ type position struct {
 func NewReader(r io.Reader) *Reader {
 	return &Reader{
 		Comma:  ',',
+		Quote:  defaultQuote,
 		source: r,
 	}
 }
@@ -158,11 +336,22 @@ func NewReader(r io.Reader) *Reader {
 func NewReaderWithOptions(r io.Reader, opts ReaderOptions) *Reader {
 	reader := NewReader(r)
 	reader.opts = extendedOptions{
-		skipBOM:      opts.SkipBOM,
-		maxInputSize: opts.MaxInputSize,
-		bufferSize:   opts.BufferSize,
-		chunkSize:    opts.ChunkSize,
-		zeroCopy:     opts.ZeroCopy,
+		skipBOM:       opts.SkipBOM,
+		maxInputSize:  opts.MaxInputSize,
+		bufferSize:    opts.BufferSize,
+		maxBufferSize: opts.MaxBufferSize,
+		chunkSize:     opts.ChunkSize,
+		zeroCopy:      opts.ZeroCopy,
+
+		header:           opts.Header,
+		headerFold:       opts.HeaderCaseInsensitive,
+		headerTrimSpace:  opts.HeaderTrimSpace,
+		headerDuplicates: opts.HeaderDuplicates,
+
+		onError:      opts.OnError,
+		errorHandler: opts.ErrorHandler,
+		maxErrors:    opts.MaxErrors,
+		quarantine:   opts.Quarantine,
 	}
 	return reader
 }
@@ -178,6 +367,9 @@ func NewReaderWithOptions(r io.Reader, opts ReaderOptions) *Reader {
 //   - On parse error: a partial record (fields before the error) and the error
 //   - On EOF: nil and io.EOF
 //
+// Under ReaderOptions.OnError, records failing with the first two are
+// dropped instead, and Read returns the next good record.
+//
 // If ReuseRecord is true, the returned slice may be shared between calls.
 func (r *Reader) Read() (record []string, err error) {
 	if err := r.ensureInitialized(); err != nil {
@@ -195,6 +387,14 @@ func (r *Reader) ReadAll() (records [][]string, err error) {
 		return nil, err
 	}
 
+	// Every returned record must stay valid: ZeroCopy windows are not
+	// recycled, and records share their allocations
+	r.state.retainWindows = true
+	defer func() {
+		r.state.retainWindows = false
+		r.state.recordText, r.state.recordSlab = nil, nil
+	}()
+
 	for {
 		record, err := r.readNextRecord()
 		if err == io.EOF {
@@ -203,14 +403,56 @@ func (r *Reader) ReadAll() (records [][]string, err error) {
 		if err != nil {
 			return records, err
 		}
-		// Defer allocation until we have a record
+		// Defer allocation until we have a record; size it from the first
+		// window, scaled to the whole input when its size is known
 		if records == nil && r.state.parseResult != nil {
-			records = make([][]string, 0, len(r.state.parseResult.rows))
+			n := len(r.state.parseResult.rows)
+			if r.state.sourceSize > 0 && len(r.state.rawBuffer) > 0 {
+				n = int(int64(n) * r.state.sourceSize / int64(len(r.state.rawBuffer)))
+			}
+			records = make([][]string, 0, n)
 		}
 		records = append(records, record)
 	}
 }
 
+// All returns an iterator over the remaining records of r, for use with range:
+//
+//	for record, err := range r.All() { ... }
+//
+// io.EOF ends the iteration and is not yielded. A *ParseError is yielded with
+// the partial record and iteration continues, so the caller decides whether
+// to break; any other error, including one wrapping ErrTooManyErrors, is
+// yielded once and ends the iteration.
+//
+// With ReuseRecord the yielded slice is reused between iterations, so a loop
+// combined with ZeroCopy reads without allocating.
+//
+// Breaking out of the loop leaves r usable: the next read continues after the
+// last yielded record. The pooled results of the current window are returned
+// at the end of the input and after an error that ends reading for good.
+func (r *Reader) All() iter.Seq2[[]string, error] {
+	return func(yield func([]string, error) bool) {
+		for {
+			record, err := r.Read()
+			if err == io.EOF {
+				return
+			}
+			more := yield(record, err)
+			if err != nil {
+				r.releaseIfDone()
+			}
+			if !more {
+				return
+			}
+			var parseErr *ParseError
+			if err != nil && (!errors.As(err, &parseErr) || errors.Is(err, ErrTooManyErrors)) {
+				return
+			}
+		}
+	}
+}
+
 // ============================================================================
 // Public API - Position and Resource Management
 // ============================================================================
@@ -226,54 +468,193 @@ func (r *Reader) FieldPos(field int) (line, column int) {
 	return p.line, p.column
 }
 
-// InputOffset returns the byte offset of the end of the most recently read row.
+// InputOffset returns the input byte offset of the current reader position:
+// the end of the most recently read record, including its terminator, and the
+// beginning of the next one. Once a read returns io.EOF it is the size of the
+// input. As in encoding/csv, comment and blank lines before a record are
+// consumed by the read that returns the record.
 func (r *Reader) InputOffset() int64 {
-	return r.state.offset
+	return r.state.inputOffset
+}
+
+// RecordOffset returns the input byte range of the most recently read
+// record: start is the offset of its first byte, after any preceding comment
+// and blank lines, and end is the offset just past its terminator. A record
+// dropped under ReaderOptions.OnError ends where reading resumes. Before the
+// first record, RecordOffset returns 0, 0.
+func (r *Reader) RecordOffset() (start, end int64) {
+	return r.state.recordStart, r.state.recordEnd
+}
+
+// Reset discards all buffered input and parsing state and makes r read from src.
+// Exported configuration fields are kept as they are; in particular, a
+// FieldsPerRecord set by Read from the first record is not restored to 0.
+//
+// Reset returns the pooled scan and parse results of the current window and
+// keeps the record-building buffers for the next source, so a Reader can be
+// reused across many small inputs without reallocating.
+//
+// Records returned before Reset remain valid, except:
+//   - With ZeroCopy, the input window and scratch buffer are recycled, so
+//     every string returned by Read or ReadAll becomes invalid.
+//   - With ReuseRecord, the slice returned by the last Read is reused as usual.
+func (r *Reader) Reset(src io.Reader) {
+	old := r.state
+	r.releasePooled()
+
+	r.state = readerState{
+		recordBuffer:    old.recordBuffer[:0],
+		fieldEnds:       old.fieldEnds[:0],
+		fieldPositions:  old.fieldPositions[:0],
+		lastRecord:      old.lastRecord,
+		lastBytesRecord: old.lastBytesRecord,
+		lastBatch:       old.lastBatch,
+		chunkHasQuote:   old.chunkHasQuote[:0],
+	}
+	r.state.window = old.window[:0]
+	if r.opts.zeroCopy {
+		r.state.fieldArena = old.fieldArena[:0]
+	}
+	r.source = src
+}
+
+// Release returns the Reader's pooled scan and parse results and drops its
+// buffers and source. Subsequent reads return ErrReaderReleased until Reset is
+// called. Records returned before Release follow the same validity rules as
+// for Reset: with ZeroCopy they must not be used afterwards.
+func (r *Reader) Release() {
+	r.releasePooled()
+	r.state = readerState{initialized: true, inputErr: ErrReaderReleased}
+	r.source = nil
+}
+
+// Close calls Release and returns nil. It does not close the underlying
+// io.Reader. Close lets a Reader be deferred as an io.Closer.
+func (r *Reader) Close() error {
+	r.Release()
+	return nil
+}
+
+// releaseIfDone returns the pooled results once a sticky error ends reading.
+// Reaching the end of the input returns them already; an indexed window is
+// kept for Seek.
+func (r *Reader) releaseIfDone() {
+	if r.state.inputErr != nil && !r.state.indexed {
+		r.releasePooled()
+	}
+}
+
+// releasePooled returns the current window's scan and parse results to their pools.
+func (r *Reader) releasePooled() {
+	r.state.scanResult.release()
+	r.state.scanResult = nil
+	r.state.parseResult.release()
+	r.state.parseResult = nil
 }
 
 // ============================================================================
 // Internal - Record Reading
 // ============================================================================
 
-// readNextRecord reads and returns the next non-comment record.
+// readNextRecord reads and returns the next non-comment record, dropping
+// malformed records under the OnError policy.
 // Returns io.EOF when no more records are available.
 func (r *Reader) readNextRecord() ([]string, error) {
+	for {
+		record, err := r.readRecord()
+		if err == nil {
+			return record, nil
+		}
+		if skip, err := r.skipRecord(err); !skip {
+			return record, err
+		}
+	}
+}
+
+// readRecord reads and returns the next non-comment record.
+func (r *Reader) readRecord() ([]string, error) {
+	rowInfo, rowIdx, err := r.nextRow()
+	if err != nil {
+		return nil, err
+	}
+
+	var record []string
+	switch {
+	case r.opts.zeroCopy:
+		record, err = r.buildRecordZeroCopyMode(rowInfo)
+	case !r.state.hasQuotes && !r.state.hasEscapes:
+		// Fast path: no quotes or escapes anywhere, so no unescape/validation needed.
+		record = r.buildRecordNoQuotes(rowInfo)
+	default:
+		record, err = r.buildRecordWithValidation(rowInfo, rowIdx)
+	}
+	if err != nil {
+		return record, err
+	}
+	return record, r.finishRecord(len(record), rowInfo)
+}
+
+// nextRow advances to the next non-comment row, loading windows as needed.
+// Returns the row and its index in the current parseResult, or io.EOF.
+func (r *Reader) nextRow() (rowInfo, int, error) {
+	if err := r.ctxErr(); err != nil {
+		return rowInfo{}, 0, err
+	}
+	if r.state.inputErr != nil {
+		return rowInfo{}, 0, r.state.inputErr
+	}
 	for {
 		if r.isAtEnd() {
-			return nil, io.EOF
+			if r.state.indexed {
+				// The window holds the whole input for random access
+				r.state.inputOffset = r.inputOffset(uint64(len(r.state.rawBuffer)))
+				return rowInfo{}, 0, io.EOF
+			}
+			if err := r.advanceWindow(); err != nil {
+				if err == io.EOF {
+					r.state.inputOffset = r.state.windowBase
+				}
+				return rowInfo{}, 0, err
+			}
+			continue
 		}
 
 		rowIdx := r.state.currentRecordIndex
-		rowInfo := r.state.parseResult.rows[rowIdx]
+		row := r.state.parseResult.rows[rowIdx]
 		r.state.currentRecordIndex++
 
 		// Skip comment lines
-		if r.Comment != 0 && r.isCommentLine(rowInfo, rowIdx) {
+		if r.Comment != 0 && r.isCommentLine(row, rowIdx) {
 			continue
 		}
+		r.state.recordCount++
+		r.setRecordSpan(row)
+		return row, rowIdx, nil
+	}
+}
 
-		// Fast path: no quotes anywhere, so no unescape/validation needed.
-		if !r.state.hasQuotes {
-			record := r.buildRecordNoQuotes(rowInfo)
-			if err := r.validateFieldCount(record, rowInfo); err != nil {
-				return record, err
-			}
-			r.state.nonCommentRecordCount++
-			return record, nil
-		}
-
-		record, err := r.buildRecordWithValidation(rowInfo, rowIdx)
-		if err != nil {
-			return record, err
-		}
-
-		if err := r.validateFieldCount(record, rowInfo); err != nil {
-			return record, err
-		}
+// setRecordSpan records the input range of row. A record ends at a single
+// byte, LF or CR, or the custom terminator; a CR before the LF of a CRLF is
+// part of the last field's raw span.
+func (r *Reader) setRecordSpan(row rowInfo) {
+	fields := r.getFieldsForRow(row, row.fieldCount)
+	if len(fields) == 0 {
+		return
+	}
+	start := int(fields[0].rawStart())
+	end := min(int(fields[len(fields)-1].rawEnd())+1, len(r.state.rawBuffer))
+	r.state.recordStart = r.inputOffset(uint64(start)) //nolint:gosec // G115: window positions are non-negative
+	r.state.recordEnd = r.inputOffset(uint64(end))     //nolint:gosec // G115: window positions are non-negative
+	r.state.inputOffset = r.state.recordEnd
+}
 
-		r.state.nonCommentRecordCount++
-		return record, nil
+// finishRecord validates the field count of a built record and counts it.
+func (r *Reader) finishRecord(fieldCount int, row rowInfo) error {
+	if err := r.validateFieldCount(fieldCount, row); err != nil {
+		return err
 	}
+	r.state.nonCommentRecordCount++
+	return nil
 }
 
 // isAtEnd reports whether all records have been read.
@@ -291,7 +672,7 @@ func (r *Reader) isAtEnd() bool {
 //   - Positive: strict validation against the configured count
 //   - Zero: auto-detect from first record, then enforce
 //   - Negative: no validation (variable field counts allowed)
-func (r *Reader) validateFieldCount(record []string, rowInfo rowInfo) error {
+func (r *Reader) validateFieldCount(fieldCount int, rowInfo rowInfo) error {
 	// No validation mode
 	if r.FieldsPerRecord < 0 {
 		return nil
@@ -299,152 +680,168 @@ func (r *Reader) validateFieldCount(record []string, rowInfo rowInfo) error {
 
 	// Auto-detect mode: set expected count from first record
 	if r.FieldsPerRecord == 0 && r.isFirstNonCommentRecord() {
-		r.FieldsPerRecord = len(record)
+		r.FieldsPerRecord = fieldCount
 		return nil
 	}
 
 	// Validate against expected count
-	if len(record) != r.FieldsPerRecord {
-		return r.fieldCountError(rowInfo.lineNum)
+	if fieldCount != r.FieldsPerRecord {
+		return r.fieldCountError(rowInfo, fieldCount)
 	}
 	return nil
 }
 
-// fieldCountError creates a ParseError for field count mismatch.
-func (r *Reader) fieldCountError(lineNum int) *ParseError {
-	return &ParseError{
-		StartLine: lineNum,
-		Line:      lineNum,
-		Column:    1,
-		Err:       ErrFieldCount,
+// fieldCountError creates a ParseError for field count mismatch, located at
+// the start of the record.
+func (r *Reader) fieldCountError(row rowInfo, fieldCount int) *ParseError {
+	var start uint64
+	if fields := r.getFieldsForRow(row, row.fieldCount); len(fields) > 0 {
+		start = uint64(fields[0].rawStart())
 	}
+	err := r.parseErrorAt(row.lineNum, start, 0, &FieldCountError{Expected: r.FieldsPerRecord, Actual: fieldCount})
+	err.Column = 1 // as encoding/csv reports it
+	return err
 }
 
 // ============================================================================
 // Internal - Initialization
 // ============================================================================
 
-// ensureInitialized performs lazy initialization on first read.
+// ensureInitialized performs lazy initialization on first read,
+// and reads the header row in header mode.
 func (r *Reader) ensureInitialized() error {
-	if r.state.initialized {
-		return nil
+	if !r.state.initialized {
+		if err := r.initialize(); err != nil {
+			return err
+		}
 	}
-	return r.initialize()
+	if r.opts.header && !r.state.headerRead {
+		return r.readHeader()
+	}
+	return r.state.headerErr
 }
 
-// initialize reads all input and runs SIMD scanning and parsing.
-// This is a one-time operation that processes the entire input.
+// initialize prepares the source for windowed reading.
+// Records are scanned and parsed one window at a time by advanceWindow,
+// so memory stays bounded regardless of input size.
 func (r *Reader) initialize() error {
 	r.state.initialized = true
 
-	if err := r.readInput(); err != nil {
-		return err
+	comma, ok := separatorBytes(r.Comma, r.Delimiter, r.Comment, quoteOrDefault(r.Quote), r.Escape)
+	if !ok {
+		r.state.inputErr = ErrInvalidDelim
+		return ErrInvalidDelim
+	}
+	if !validTerminator(r.Terminator, comma, r.Comment, quoteOrDefault(r.Quote), r.Escape) {
+		r.state.inputErr = ErrInvalidDelim
+		return ErrInvalidDelim
+	}
+	r.state.comma = comma
+	if r.Comment != 0 {
+		r.state.comment = utf8.AppendRune(nil, r.Comment)
 	}
 
-	r.skipUTF8BOM()
-
-	// Empty input: no records
-	if len(r.state.rawBuffer) == 0 {
-		r.state.parseResult = parseResultPool.Get().(*parseResult)
-		r.state.parseResult.reset()
-		return nil
+	r.state.sourceSize = sourceSizeHint(r.source)
+	if maxSize := r.opts.maxInputSize; maxSize > 0 {
+		// Read one byte past the limit so oversized input can be detected
+		r.source = io.LimitReader(r.source, maxSize+1)
 	}
+	return nil
+}
 
-	// Scan: structural analysis using SIMD (generates bitmasks)
-	r.state.scanResult = scanBuffer(r.state.rawBuffer, byte(r.Comma))
+// maxDelimiterLen is the maximum length of Delimiter in bytes.
+// The scanner confirms a separator from the chunk holding its lead byte and the next one.
+const maxDelimiterLen = simdChunkSize
 
-	// Copy scan flags for fast path optimizations
-	r.state.hasQuotes = r.state.scanResult.hasQuotes
-	r.state.hasCR = r.state.scanResult.hasCR
-	r.copyChunkHasQuote()
+// separatorBytes validates the delimiter configuration and returns the encoded
+// field separator: delimiter if it is not empty, otherwise comma.
+func separatorBytes(comma rune, delimiter string, comment, quote, escape rune) ([]byte, bool) {
+	if delimiter == "" {
+		if !validDelims(comma, comment, quote) || !validEscape(escape, comma, comment, quote) {
+			return nil, false
+		}
+		return utf8.AppendRune(nil, comma), true
+	}
 
-	// Parse: extract fields and rows from scan result
-	r.state.parseResult = parseBuffer(r.state.rawBuffer, r.state.scanResult)
+	if !validQuote(quote) || comment == quote || (comment != 0 && !validDelim(comment)) ||
+		!validEscape(escape, 0, comment, quote) || !validDelimiter(delimiter, comment, quote, escape) {
+		return nil, false
+	}
+	return []byte(delimiter), true
+}
 
-	// Release scanResult (no longer needed after parsing)
-	r.state.scanResult.release()
-	r.state.scanResult = nil
+// validDelimiter reports whether delimiter can be used as a multi-character field delimiter.
+// Zero comment and escape values are ignored.
+func validDelimiter(delimiter string, comment, quote, escape rune) bool {
+	if len(delimiter) > maxDelimiterLen || !utf8.ValidString(delimiter) ||
+		strings.ContainsAny(delimiter, "\x00\r\n\uFFFD") || strings.ContainsRune(delimiter, quote) {
+		return false
+	}
+	return (comment == 0 || !strings.ContainsRune(delimiter, comment)) &&
+		(escape == 0 || !strings.ContainsRune(delimiter, escape))
+}
 
-	r.state.offset = int64(len(r.state.rawBuffer))
-	return nil
+// validDelims reports whether comma, comment and quote form a valid delimiter configuration.
+// The rules follow encoding/csv, with quote taking the place of the fixed '"'.
+func validDelims(comma, comment, quote rune) bool {
+	return validQuote(quote) && comma != quote && comment != quote &&
+		comma != comment && validDelim(comma) && (comment == 0 || validDelim(comment))
 }
 
-// ============================================================================
-// Internal - Input Reading
-// ============================================================================
+// validDelim reports whether r can be used as a field or comment delimiter.
+func validDelim(r rune) bool {
+	return r != 0 && r != '\r' && r != '\n' && utf8.ValidRune(r) && r != utf8.RuneError
+}
 
-// readInput reads the entire input into rawBuffer with size limiting.
-func (r *Reader) readInput() error {
-	maxSize := r.opts.maxInputSize
-	if maxSize == 0 {
-		maxSize = DefaultMaxInputSize
-	}
+// validQuote reports whether r can be used as the quote character.
+// The quote must be a single byte so the scanner can match it in SIMD.
+func validQuote(r rune) bool {
+	return r > 0 && r < utf8.RuneSelf && r != '\r' && r != '\n'
+}
 
-	// Try to determine input size for pre-allocation
-	var initialCap int64
-	if seeker, ok := r.source.(io.Seeker); ok {
-		if size, err := seeker.Seek(0, io.SeekEnd); err == nil {
-			initialCap = size
-			_, _ = seeker.Seek(0, io.SeekStart)
-		}
-	}
+// quoteByte returns the configured quote character.
+func (r *Reader) quoteByte() byte {
+	return byte(quoteOrDefault(r.Quote))
+}
 
-	var err error
-	if maxSize > 0 {
-		// Enforce size limit
-		limited := io.LimitReader(r.source, maxSize+1)
-		r.state.rawBuffer, err = readAllWithPool(limited, initialCap)
+// sourceSizeHint returns the number of bytes remaining in r, or -1 if unknown.
+// The hint only caps window allocation for small inputs; it is never trusted for correctness.
+func sourceSizeHint(r io.Reader) int64 {
+	switch sr := r.(type) {
+	case interface{ Len() int }:
+		return int64(sr.Len()) // strings.Reader, bytes.Reader, bytes.Buffer
+	case io.Seeker:
+		cur, err := sr.Seek(0, io.SeekCurrent)
 		if err != nil {
-			return err
-		}
-		if int64(len(r.state.rawBuffer)) > maxSize {
-			return ErrInputTooLarge
+			return -1
 		}
-	} else {
-		// No limit (maxSize == -1)
-		r.state.rawBuffer, err = readAllWithPool(r.source, initialCap)
-	}
-	return err
-}
-
-// readAllWithPool reads all data from r, pre-allocating if size is known.
-// Returns a slice that may be from a pool (caller should return via releaseRawBuffer).
-func readAllWithPool(r io.Reader, initialCap int64) ([]byte, error) {
-	// Try to determine size from common reader types
-	if initialCap == 0 {
-		switch sr := r.(type) {
-		case interface{ Len() int }:
-			initialCap = int64(sr.Len()) // strings.Reader, bytes.Reader, bytes.Buffer
-		case interface{ Size() int64 }:
-			initialCap = sr.Size() // strings.Reader also has Size()
+		end, err := sr.Seek(0, io.SeekEnd)
+		if err != nil {
+			return -1
 		}
-	}
-
-	// Pre-allocate if size is known
-	if initialCap > 0 {
-		buf := make([]byte, initialCap)
-		n, err := io.ReadFull(r, buf)
-		if err == io.ErrUnexpectedEOF || err == io.EOF {
-			return buf[:n], nil
+		if _, err := sr.Seek(cur, io.SeekStart); err != nil {
+			return -1
 		}
-		return buf[:n], err
+		return end - cur
 	}
-
-	return io.ReadAll(r)
+	return -1
 }
 
 // ============================================================================
 // Internal - BOM and Chunk Processing
 // ============================================================================
 
-// skipUTF8BOM removes the UTF-8 BOM (EF BB BF) from the beginning of rawBuffer if present.
-func (r *Reader) skipUTF8BOM() {
-	if !r.opts.skipBOM || len(r.state.rawBuffer) < 3 {
-		return
+// skipUTF8BOM removes the UTF-8 BOM (EF BB BF) from the beginning of buf if present.
+// Only the first window of the input is checked.
+func (r *Reader) skipUTF8BOM(buf []byte) []byte {
+	if !r.opts.skipBOM || r.state.windowBase != 0 || len(buf) < 3 {
+		return buf
 	}
-	if r.state.rawBuffer[0] == 0xEF && r.state.rawBuffer[1] == 0xBB && r.state.rawBuffer[2] == 0xBF {
-		r.state.rawBuffer = r.state.rawBuffer[3:]
+	if buf[0] == 0xEF && buf[1] == 0xBB && buf[2] == 0xBF {
+		r.state.windowBase = 3
+		return buf[3:]
 	}
+	return buf
 }
 
 // copyChunkHasQuote copies per-chunk quote presence flags for validation fast path.
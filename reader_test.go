@@ -3,11 +3,20 @@
 package simdcsv
 
 import (
+	"bytes"
 	"encoding/csv"
+	"errors"
+	"fmt"
 	"io"
 	"reflect"
+	"runtime"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
+	"testing/iotest"
+	"unicode/utf8"
+	"unsafe"
 )
 
 // =============================================================================
@@ -649,6 +658,30 @@ func TestRead_CustomSeparator(t *testing.T) {
 			comma: '\t',
 			want:  [][]string{{"a,b", "c"}},
 		},
+		{
+			name:  "section sign separator",
+			input: "a§b§c\n1§2§3\n",
+			comma: '§',
+			want:  [][]string{{"a", "b", "c"}, {"1", "2", "3"}},
+		},
+		{
+			name:  "broken bar with same lead byte in fields",
+			input: "©¦®¦a\n\"§¦\"¦©\n",
+			comma: '¦',
+			want:  [][]string{{"©", "®", "a"}, {"§¦", "©"}},
+		},
+		{
+			name:  "full-width comma separator",
+			input: "名前，値\n\"引用，中\"，x\r\n，\n",
+			comma: '，',
+			want:  [][]string{{"名前", "値"}, {"引用，中", "x"}, {"", ""}},
+		},
+		{
+			name:  "four-byte separator",
+			input: "a😀b😀\nc😀d😀e\n",
+			comma: '😀',
+			want:  [][]string{{"a", "b", ""}, {"c", "d", "e"}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -697,6 +730,12 @@ func TestRead_Comment(t *testing.T) {
 			comment: 0,
 			want:    [][]string{{"a", "b"}, {"#not a comment"}},
 		},
+		{
+			name:    "multi-byte comment",
+			input:   "a,b\n§comment\n¶not a comment\nc,d\n",
+			comment: '§',
+			want:    [][]string{{"a", "b"}, {"¶not a comment"}, {"c", "d"}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -707,6 +746,251 @@ func TestRead_Comment(t *testing.T) {
 	}
 }
 
+// TestRead_MultiByteCommaChunkBoundary tests multi-byte separators straddling 64-byte chunks.
+func TestRead_MultiByteCommaChunkBoundary(t *testing.T) {
+	for pad := 0; pad < 8; pad++ {
+		var b strings.Builder
+		for i := 0; i < 40; i++ {
+			fmt.Fprintf(&b, "%s%d，\"q，%d\"，end\n", strings.Repeat("x", pad+i%5), i, i)
+		}
+		input := b.String()
+
+		t.Run(fmt.Sprintf("pad=%d", pad), func(t *testing.T) {
+			compareWithStdlib(t, input, &readerOptions{comma: '，'})
+		})
+	}
+}
+
+// TestRead_InvalidDelim tests that invalid Comma and Comment combinations are rejected.
+func TestRead_InvalidDelim(t *testing.T) {
+	tests := []struct {
+		name    string
+		comma   rune
+		comment rune
+	}{
+		{"zero comma", 0, 0},
+		{"quote comma", '"', 0},
+		{"newline comma", '\n', 0},
+		{"carriage return comma", '\r', 0},
+		{"replacement char comma", utf8.RuneError, 0},
+		{"invalid rune comma", 0xD800, 0},
+		{"comment equals comma", ',', ','},
+		{"newline comment", ',', '\n'},
+		{"quote comment", ',', '"'},
+		{"invalid rune comment", ',', -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			std := csv.NewReader(strings.NewReader("a,b\n"))
+			std.Comma, std.Comment = tt.comma, tt.comment
+			if _, err := std.Read(); err == nil {
+				t.Fatalf("encoding/csv accepted comma %q comment %q", tt.comma, tt.comment)
+			}
+
+			r := NewReader(strings.NewReader("a,b\n"))
+			r.Comma, r.Comment = tt.comma, tt.comment
+			if _, err := r.Read(); !errors.Is(err, ErrInvalidDelim) {
+				t.Errorf("Read: got %v, want ErrInvalidDelim", err)
+			}
+			if _, err := r.Read(); !errors.Is(err, ErrInvalidDelim) {
+				t.Errorf("subsequent Read: got %v, want ErrInvalidDelim", err)
+			}
+		})
+	}
+}
+
+// TestRead_Quote tests parsing with a custom quote character.
+func TestRead_Quote(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    [][]string
+		wantErr error
+	}{
+		{name: "single quoted", input: "'a,b','c'\n", want: [][]string{{"a,b", "c"}}},
+		{name: "doubled quote", input: "'it''s',x\n", want: [][]string{{"it's", "x"}}},
+		{name: "double quote is literal", input: `"a",'say "hi"'` + "\n", want: [][]string{{`"a"`, `say "hi"`}}},
+		{name: "multiline", input: "'a\r\nb',c\n", want: [][]string{{"a\nb", "c"}}},
+		{name: "extraneous quote", input: "'a'b,c\n", wantErr: ErrQuote},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReader(strings.NewReader(tt.input))
+			r.Quote = '\''
+			got, err := r.ReadAll()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReadAll error: got %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadAll = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRead_QuoteMatchesStdlib verifies single-quoted input parses like the
+// double-quoted equivalent across window, parallel and ZeroCopy paths.
+func TestRead_QuoteMatchesStdlib(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	input := generateWindowedCSV(64 * 1024)
+	swap := strings.NewReplacer(`"`, "'", "'", `"`)
+	want := readAllStdlib(t, input)
+	for _, record := range want {
+		for i := range record {
+			record[i] = swap.Replace(record[i])
+		}
+	}
+
+	for _, opts := range []ReaderOptions{{}, {BufferSize: 100}, {ChunkSize: 256}, {ZeroCopy: true}} {
+		t.Run(fmt.Sprintf("%+v", opts), func(t *testing.T) {
+			r := NewReaderWithOptions(strings.NewReader(swap.Replace(input)), opts)
+			r.Quote = '\''
+			r.FieldsPerRecord = -1
+			got, err := r.ReadAll()
+			if err != nil {
+				t.Fatalf("ReadAll error: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ReadAll mismatch: got %d records, want %d", len(got), len(want))
+			}
+		})
+	}
+}
+
+// TestRead_InvalidQuote tests that invalid Quote settings are rejected.
+func TestRead_InvalidQuote(t *testing.T) {
+	tests := []struct {
+		name    string
+		quote   rune
+		comma   rune
+		comment rune
+	}{
+		{"non-ASCII quote", 'é', ',', 0},
+		{"newline quote", '\n', ',', 0},
+		{"quote equals comma", ';', ';', 0},
+		{"quote equals comment", '#', ',', '#'},
+		{"default quote as comma", 0, '"', 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReader(strings.NewReader("a,b\n"))
+			r.Quote, r.Comma, r.Comment = tt.quote, tt.comma, tt.comment
+			if _, err := r.Read(); !errors.Is(err, ErrInvalidDelim) {
+				t.Errorf("Read: got %v, want ErrInvalidDelim", err)
+			}
+		})
+	}
+
+	// A double quote is an ordinary delimiter once Quote is changed
+	r := NewReader(strings.NewReader("a\"b\n"))
+	r.Quote, r.Comma = '\'', '"'
+	got, err := r.Read()
+	if err != nil || !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Read = %q, %v; want [a b]", got, err)
+	}
+}
+
+// TestRead_Delimiter tests parsing with a multi-character Delimiter.
+func TestRead_Delimiter(t *testing.T) {
+	tests := []struct {
+		name      string
+		delimiter string
+		input     string
+		want      [][]string
+	}{
+		{name: "double pipe", delimiter: "||", input: "a||b||c\n", want: [][]string{{"a", "b", "c"}}},
+		{name: "single pipe is data", delimiter: "||", input: "a|b||c|\n", want: [][]string{{"a|b", "c|"}}},
+		{name: "leftmost match", delimiter: "||", input: "a|||b\n", want: [][]string{{"a", "|b"}}},
+		{name: "four pipes", delimiter: "||", input: "a||||b\n", want: [][]string{{"a", "", "b"}}},
+		{name: "tilde pipe tilde", delimiter: "~|~", input: "a~|~b~c~|~|d\n", want: [][]string{{"a", "b~c", "|d"}}},
+		{name: "overlapping tilde", delimiter: "~|~", input: "a~|~|~b\n", want: [][]string{{"a", "|~b"}}},
+		{name: "quoted delimiter", delimiter: "||", input: "\"x||y\"||z\r\n", want: [][]string{{"x||y", "z"}}},
+		{name: "empty fields", delimiter: "||", input: "||\n", want: [][]string{{"", ""}}},
+		{name: "multi-byte runes", delimiter: "→→", input: "a→b→→c\n", want: [][]string{{"a→b", "c"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReader(strings.NewReader(tt.input))
+			r.Delimiter = tt.delimiter
+			got, err := r.ReadAll()
+			if err != nil {
+				t.Fatalf("ReadAll error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadAll = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRead_DelimiterMatchesStdlib verifies multi-character delimiters parse
+// like the comma-separated equivalent, including delimiters straddling chunk,
+// piece and window boundaries.
+func TestRead_DelimiterMatchesStdlib(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	input := generateWindowedCSV(64 * 1024)
+	want := readAllStdlib(t, input)
+
+	for _, delimiter := range []string{"||", "~|~", "§§", strings.Repeat("#", maxDelimiterLen)} {
+		expected := make([][]string, len(want))
+		for i, record := range want {
+			expected[i] = make([]string, len(record))
+			for j, field := range record {
+				expected[i][j] = strings.ReplaceAll(field, ",", delimiter)
+			}
+		}
+
+		for _, opts := range []ReaderOptions{{}, {BufferSize: 100}, {ChunkSize: 256}, {ZeroCopy: true}} {
+			t.Run(fmt.Sprintf("%s/%+v", delimiter[:min(len(delimiter), 4)], opts), func(t *testing.T) {
+				r := NewReaderWithOptions(strings.NewReader(strings.ReplaceAll(input, ",", delimiter)), opts)
+				r.Delimiter = delimiter
+				r.FieldsPerRecord = -1
+				got, err := r.ReadAll()
+				if err != nil {
+					t.Fatalf("ReadAll error: %v", err)
+				}
+				if !reflect.DeepEqual(got, expected) {
+					t.Errorf("ReadAll mismatch: got %d records, want %d", len(got), len(expected))
+				}
+			})
+		}
+	}
+}
+
+// TestRead_InvalidDelimiter tests that invalid Delimiter settings are rejected.
+func TestRead_InvalidDelimiter(t *testing.T) {
+	tests := []struct {
+		name      string
+		delimiter string
+		comment   rune
+		escape    rune
+	}{
+		{name: "contains quote", delimiter: `|"`},
+		{name: "contains newline", delimiter: "|\n"},
+		{name: "contains NUL", delimiter: "|\x00"},
+		{name: "invalid UTF-8", delimiter: "|\xff"},
+		{name: "too long", delimiter: strings.Repeat("|", maxDelimiterLen+1)},
+		{name: "contains comment", delimiter: "#|", comment: '#'},
+		{name: "contains escape", delimiter: `\|`, escape: '\\'},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReader(strings.NewReader("a||b\n"))
+			r.Delimiter, r.Comment, r.Escape = tt.delimiter, tt.comment, tt.escape
+			if _, err := r.Read(); !errors.Is(err, ErrInvalidDelim) {
+				t.Errorf("Read: got %v, want ErrInvalidDelim", err)
+			}
+		})
+	}
+}
+
 // TestRead_TrimLeadingSpace tests trimming of leading whitespace.
 func TestRead_TrimLeadingSpace(t *testing.T) {
 	tests := []struct {
@@ -1026,10 +1310,72 @@ func TestInputOffset_Basic(t *testing.T) {
 		t.Fatalf("Read error: %v", err)
 	}
 
-	// After reading all (our implementation reads entire input on first Read)
-	offset := reader.InputOffset()
-	if offset != int64(len(input)) {
-		t.Errorf("After first Read InputOffset: got %d, want %d", offset, len(input))
+	// The offset is the end of the first record, not of the buffered input
+	if offset := reader.InputOffset(); offset != 6 {
+		t.Errorf("After first Read InputOffset: got %d, want 6", offset)
+	}
+	if start, end := reader.RecordOffset(); start != 0 || end != 6 {
+		t.Errorf("RecordOffset() = %d, %d, want 0, 6", start, end)
+	}
+}
+
+// TestInputOffset_MatchesEncodingCSV compares InputOffset after each record with encoding/csv.
+func TestInputOffset_MatchesEncodingCSV(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"LF", "a,b,c\n1,2,3\n"},
+		{"CRLF", "a,b\r\n\"x\r\ny\",z\r\nc,d"},
+		{"Comments", "# head\na,b\n# mid\n\n\nc,d\n# tail\n\n"},
+		{"BlankLines", "\n\na,b\n\r\n\nc,d\n\n"},
+		{"Windows", generateWindowedCSV(3 * 4096)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			std := csv.NewReader(strings.NewReader(tt.input))
+			std.Comment = '#'
+			r := NewReaderWithOptions(strings.NewReader(tt.input), ReaderOptions{BufferSize: 4096})
+			r.Comment = '#'
+			for n := 0; ; n++ {
+				_, wantErr := std.Read()
+				_, err := r.Read()
+				if err != wantErr {
+					t.Fatalf("record %d: Read error = %v, want %v", n, err, wantErr)
+				}
+				if got, want := r.InputOffset(), std.InputOffset(); got != want {
+					t.Fatalf("record %d: InputOffset() = %d, want %d", n, got, want)
+				}
+				if err == io.EOF {
+					break
+				}
+			}
+		})
+	}
+}
+
+// TestRecordOffset tests the span of each record, which excludes comments and blank lines.
+func TestRecordOffset(t *testing.T) {
+	input := "\xEF\xBB\xBFa,b\r\n# note\r\n\r\n\"c\r\nd\",e\r\nf,g"
+	r := NewReaderWithOptions(strings.NewReader(input), ReaderOptions{SkipBOM: true})
+	r.Comment = '#'
+	for _, want := range []string{"a,b\r\n", "\"c\r\nd\",e\r\n", "f,g"} {
+		if _, err := r.Read(); err != nil {
+			t.Fatalf("Read error: %v", err)
+		}
+		start, end := r.RecordOffset()
+		if got := input[start:end]; got != want {
+			t.Errorf("RecordOffset() = %d, %d spanning %q, want %q", start, end, got, want)
+		}
+		if string(r.RawRecord()) != want {
+			t.Errorf("RawRecord() = %q, want %q", r.RawRecord(), want)
+		}
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Fatalf("Read at end = %v, want io.EOF", err)
+	}
+	if start, end := r.RecordOffset(); input[start:end] != "f,g" {
+		t.Errorf("RecordOffset() after io.EOF = %d, %d, want the last record", start, end)
 	}
 }
 
@@ -1116,21 +1462,25 @@ func TestNewReaderWithOptions_SkipBOM(t *testing.T) {
 // TestNewReaderWithOptions_OptionsApplied verifies all options are applied to Reader.
 func TestNewReaderWithOptions_OptionsApplied(t *testing.T) {
 	opts := ReaderOptions{
-		BufferSize: 4096,
-		ChunkSize:  128,
-		ZeroCopy:   true,
-		SkipBOM:    true,
+		BufferSize:    4096,
+		MaxBufferSize: 1 << 20,
+		ChunkSize:     128,
+		ZeroCopy:      true,
+		SkipBOM:       true,
 	}
 
 	reader := NewReaderWithOptions(strings.NewReader("a,b,c\n"), opts)
 
-	// Verify internal fields are set (these are currently reserved for future use)
+	// Verify internal fields are set
 	if reader.opts.skipBOM != opts.SkipBOM {
 		t.Errorf("skipBOM not applied: got %v, want %v", reader.opts.skipBOM, opts.SkipBOM)
 	}
 	if reader.opts.bufferSize != opts.BufferSize {
 		t.Errorf("bufferSize not applied: got %v, want %v", reader.opts.bufferSize, opts.BufferSize)
 	}
+	if reader.opts.maxBufferSize != opts.MaxBufferSize {
+		t.Errorf("maxBufferSize not applied: got %v, want %v", reader.opts.maxBufferSize, opts.MaxBufferSize)
+	}
 	if reader.opts.chunkSize != opts.ChunkSize {
 		t.Errorf("chunkSize not applied: got %v, want %v", reader.opts.chunkSize, opts.ChunkSize)
 	}
@@ -1138,3 +1488,776 @@ func TestNewReaderWithOptions_OptionsApplied(t *testing.T) {
 		t.Errorf("zeroCopy not applied: got %v, want %v", reader.opts.zeroCopy, opts.ZeroCopy)
 	}
 }
+
+// =============================================================================
+// ZeroCopy Tests
+// =============================================================================
+
+// TestZeroCopy_MatchesStdlib verifies ZeroCopy records equal encoding/csv output.
+func TestZeroCopy_MatchesStdlib(t *testing.T) {
+	inputs := []string{
+		"a,b,c\n1,2,3\n",
+		`"a ""quoted"" value",plain,"has,comma"` + "\n",
+		"\"multi\r\nline\",x\r\ny,z\r\n",
+		"  \"trimmed\",  b\n",
+		generateWindowedCSV(2 * defaultBufferSize),
+	}
+
+	for i, input := range inputs {
+		for _, trim := range []bool{false, true} {
+			t.Run(fmt.Sprintf("input%d/trim=%v", i, trim), func(t *testing.T) {
+				std := csv.NewReader(strings.NewReader(input))
+				std.FieldsPerRecord = -1
+				std.TrimLeadingSpace = trim
+
+				r := NewReaderWithOptions(strings.NewReader(input), ReaderOptions{ZeroCopy: true})
+				r.FieldsPerRecord = -1
+				r.TrimLeadingSpace = trim
+
+				for n := 0; ; n++ {
+					want, stdErr := std.Read()
+					got, err := r.Read()
+					if stdErr == io.EOF {
+						if err != io.EOF {
+							t.Fatalf("record %d: got %v, want io.EOF", n, err)
+						}
+						break
+					}
+					if (err != nil) != (stdErr != nil) {
+						t.Fatalf("record %d: got error %v, want %v", n, err, stdErr)
+					}
+					if err != nil {
+						break
+					}
+					if !reflect.DeepEqual(got, want) {
+						t.Fatalf("record %d: got %q, want %q", n, got, want)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestZeroCopy_Aliasing verifies only transformed fields are copied.
+func TestZeroCopy_Aliasing(t *testing.T) {
+	input := `plain,"quoted","esc""aped"` + "\n"
+	r := NewReaderWithOptions(strings.NewReader(input), ReaderOptions{ZeroCopy: true})
+
+	record, err := r.Read()
+	if err != nil {
+		t.Fatalf("Read error: %v", err)
+	}
+
+	window := r.state.rawBuffer
+	aliases := func(s string) bool {
+		p := uintptr(unsafe.Pointer(unsafe.StringData(s)))
+		start := uintptr(unsafe.Pointer(unsafe.SliceData(window)))
+		return p >= start && p < start+uintptr(len(window))
+	}
+
+	for i, want := range []bool{true, true, false} {
+		if got := aliases(record[i]); got != want {
+			t.Errorf("field %d (%q) aliases input = %v, want %v", i, record[i], got, want)
+		}
+	}
+}
+
+// TestZeroCopy_NoAllocations verifies steady-state Read does not allocate.
+func TestZeroCopy_NoAllocations(t *testing.T) {
+	src := &repeatReader{row: `1,"esc""aped",plain` + "\r\n", remaining: 1 << 20}
+	r := NewReaderWithOptions(src, ReaderOptions{ZeroCopy: true, BufferSize: 4096})
+	r.ReuseRecord = true
+
+	// Warm up buffers and pools
+	for i := 0; i < 1000; i++ {
+		if _, err := r.Read(); err != nil {
+			t.Fatalf("Read error: %v", err)
+		}
+	}
+
+	allocs := testing.AllocsPerRun(10000, func() {
+		if _, err := r.Read(); err != nil {
+			t.Fatalf("Read error: %v", err)
+		}
+	})
+	if allocs > 0.01 {
+		t.Errorf("got %.3f allocs per Read, want 0", allocs)
+	}
+}
+
+// TestZeroCopy_ReadAllRetainsRecords verifies ReadAll records survive window recycling.
+func TestZeroCopy_ReadAllRetainsRecords(t *testing.T) {
+	input := generateWindowedCSV(4 * 4096)
+	want := readAllStdlib(t, input)
+
+	r := NewReaderWithOptions(iotest.HalfReader(strings.NewReader(input)), ReaderOptions{ZeroCopy: true, BufferSize: 4096})
+	r.FieldsPerRecord = -1
+	got, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadAll mismatch: got %d records, want %d", len(got), len(want))
+	}
+}
+
+// =============================================================================
+// Escape Tests
+// =============================================================================
+
+// TestRead_Escape tests the backslash-escape dialect.
+func TestRead_Escape(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    [][]string
+		wantErr error
+	}{
+		{name: "escaped comma", input: `a\,b,c` + "\n", want: [][]string{{"a,b", "c"}}},
+		{name: "escaped quote in quoted field", input: `"say \"hi\"",x` + "\n", want: [][]string{{`say "hi"`, "x"}}},
+		{name: "escaped quote in unquoted field", input: `a\"b,c` + "\n", want: [][]string{{`a"b`, "c"}}},
+		{name: "escaped backslash", input: `a\\,b` + "\n", want: [][]string{{`a\`, "b"}}},
+		{name: "escaped backslash before quote", input: `"a\\",b` + "\n", want: [][]string{{`a\`, "b"}}},
+		{name: "decoded sequences", input: `a\tb\nc\rd,e` + "\n", want: [][]string{{"a\tb\nc\rd", "e"}}},
+		{name: "escaped newline", input: "a\\\nb,c\n", want: [][]string{{"a\nb", "c"}}},
+		{name: "null", input: `1,\N,3` + "\n", want: [][]string{{"1", "", "3"}}},
+		{name: "N inside field", input: `a\Nb` + "\n", want: [][]string{{"aNb"}}},
+		{name: "doubled quote still accepted", input: `"a""b"` + "\n", want: [][]string{{`a"b`}}},
+		{name: "unterminated quoted field", input: `"a\"` + "\n", wantErr: ErrQuote},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, opts := range []ReaderOptions{{}, {ZeroCopy: true}} {
+				r := NewReaderWithOptions(strings.NewReader(tt.input), opts)
+				r.Escape = '\\'
+				got, err := r.ReadAll()
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ReadAll(%+v) error: got %v, want %v", opts, err, tt.wantErr)
+				}
+				if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("ReadAll(%+v) = %q, want %q", opts, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// TestReadRow_IsNull tests that an unquoted \N is NULL only with Escape set.
+func TestReadRow_IsNull(t *testing.T) {
+	input := `1,\N,,"\N",a\N` + "\n"
+	tests := []struct {
+		escape rune
+		want   []bool
+	}{
+		{'\\', []bool{false, true, false, false, false}},
+		{0, []bool{false, false, false, false, false}},
+	}
+
+	for _, tt := range tests {
+		r := NewReader(strings.NewReader(input))
+		r.Escape = tt.escape
+		row, err := r.ReadRow()
+		if err != nil {
+			t.Fatalf("Escape %q: ReadRow error: %v", tt.escape, err)
+		}
+		for i, want := range tt.want {
+			if got := row.IsNull(i); got != want {
+				t.Errorf("Escape %q: IsNull(%d) = %v, want %v", tt.escape, i, got, want)
+			}
+		}
+		if tt.escape != 0 && row.Field(1) != "" {
+			t.Errorf("Field(1) = %q, want \"\"", row.Field(1))
+		}
+	}
+}
+
+// TestRead_EscapeRoundTrip verifies Writer output in escape mode reads back
+// unchanged, with escapes falling on chunk and window boundaries.
+func TestRead_EscapeRoundTrip(t *testing.T) {
+	var records [][]string
+	for i := 0; i < 500; i++ {
+		pad := strings.Repeat("x", i%67)
+		records = append(records, []string{
+			pad + `\`,
+			pad + `"q",` + pad,
+			`\N`,
+			pad + "\n" + pad + "\r\n",
+			strconv.Itoa(i),
+		})
+	}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.Escape = '\\'
+	if err := w.WriteAll(records); err != nil {
+		t.Fatalf("WriteAll error: %v", err)
+	}
+	if n := strings.Count(buf.String(), "\n"); n != len(records) {
+		t.Fatalf("escaped output has %d lines, want %d", n, len(records))
+	}
+
+	for _, opts := range []ReaderOptions{{}, {BufferSize: 100}, {ChunkSize: 256}, {ZeroCopy: true, BufferSize: 1000}} {
+		t.Run(fmt.Sprintf("%+v", opts), func(t *testing.T) {
+			r := NewReaderWithOptions(iotest.HalfReader(bytes.NewReader(buf.Bytes())), opts)
+			r.Escape = '\\'
+			got, err := r.ReadAll()
+			if err != nil {
+				t.Fatalf("ReadAll error: %v", err)
+			}
+			if !reflect.DeepEqual(got, records) {
+				t.Errorf("ReadAll mismatch: got %d records, want %d", len(got), len(records))
+			}
+		})
+	}
+}
+
+// TestRead_InvalidEscape tests that invalid Escape settings are rejected.
+func TestRead_InvalidEscape(t *testing.T) {
+	for _, escape := range []rune{',', '"', '\n', 'é'} {
+		r := NewReader(strings.NewReader("a,b\n"))
+		r.Escape = escape
+		if _, err := r.Read(); !errors.Is(err, ErrInvalidDelim) {
+			t.Errorf("Read with Escape %q: got %v, want ErrInvalidDelim", escape, err)
+		}
+	}
+}
+
+// =============================================================================
+// Terminator Tests
+// =============================================================================
+
+// TestRead_Terminator tests each record terminator setting.
+func TestRead_Terminator(t *testing.T) {
+	tests := []struct {
+		name       string
+		terminator Terminator
+		input      string
+		want       [][]string
+	}{
+		{name: "any", terminator: TerminatorAny, input: "a\nb\r\nc\rd", want: [][]string{{"a"}, {"b"}, {"c"}, {"d"}}},
+		{name: "LF drops CR before LF", terminator: TerminatorLF, input: "a,b\r\nc\n", want: [][]string{{"a", "b"}, {"c"}}},
+		{name: "LF keeps lone CR", terminator: TerminatorLF, input: "a\rb,c\r\n", want: [][]string{{"a\rb", "c"}}},
+		{name: "CRLF only", terminator: TerminatorCRLF, input: "a,b\r\nc\nd\re\r\n", want: [][]string{{"a", "b"}, {"c\nd\re"}}},
+		{name: "CRLF without final terminator", terminator: TerminatorCRLF, input: "a\r\nb\n", want: [][]string{{"a"}, {"b\n"}}},
+		{name: "CR", terminator: TerminatorCR, input: "a\rb\nc\r", want: [][]string{{"a"}, {"b\nc"}}},
+		{name: "CR quoted", terminator: TerminatorCR, input: "\"a\rb\",c\rd", want: [][]string{{"a\rb", "c"}, {"d"}}},
+		{name: "CR keeps quoted CRLF", terminator: TerminatorCR, input: "\"a\r\nb\",\"x\"\"y\"\rc", want: [][]string{{"a\r\nb", "x\"y"}, {"c"}}},
+		{name: "byte keeps quoted CRLF", terminator: TerminatorByte(';'), input: "\"a\r\nb\";\"c\r\n\"\"\";", want: [][]string{{"a\r\nb"}, {"c\r\n\""}}},
+		{name: "RS", terminator: TerminatorByte(0x1E), input: "a,b\x1ec\nd\x1e", want: [][]string{{"a", "b"}, {"c\nd"}}},
+		{name: "RS quoted", terminator: TerminatorByte(0x1E), input: "\"a\x1eb\"\x1ec", want: [][]string{{"a\x1eb"}, {"c"}}},
+		{name: "RS empty final field", terminator: TerminatorByte(0x1E), input: "a,\x1e", want: [][]string{{"a", ""}}},
+		{name: "RS blank record", terminator: TerminatorByte(0x1E), input: "a\x1e\x1eb", want: [][]string{{"a"}, {"b"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, opts := range []ReaderOptions{{}, {ZeroCopy: true}} {
+				r := NewReaderWithOptions(strings.NewReader(tt.input), opts)
+				r.Terminator = tt.terminator
+				r.FieldsPerRecord = -1
+				got, err := r.ReadAll()
+				if err != nil {
+					t.Fatalf("ReadAll(%+v) error: %v", opts, err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("ReadAll(%+v) = %q, want %q", opts, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// TestRead_TerminatorRoundTrip verifies Writer output with each terminator
+// reads back unchanged, with terminators falling on chunk and window boundaries.
+func TestRead_TerminatorRoundTrip(t *testing.T) {
+	var records [][]string
+	for i := 0; i < 500; i++ {
+		pad := strings.Repeat("x", i%67)
+		records = append(records, []string{
+			pad,
+			pad + "\n" + pad,
+			"\r" + pad,
+			strconv.Itoa(i),
+		})
+	}
+
+	terminators := []Terminator{TerminatorLF, TerminatorCRLF, TerminatorCR, TerminatorByte(0x1E)}
+	for _, term := range terminators {
+		var buf bytes.Buffer
+		w := NewWriter(&buf)
+		w.Terminator = term
+		if err := w.WriteAll(records); err != nil {
+			t.Fatalf("WriteAll(%#x) error: %v", term, err)
+		}
+
+		for _, opts := range []ReaderOptions{{}, {BufferSize: 100}, {ChunkSize: 256}, {ZeroCopy: true, BufferSize: 1000}} {
+			t.Run(fmt.Sprintf("%#x/%+v", term, opts), func(t *testing.T) {
+				r := NewReaderWithOptions(iotest.HalfReader(bytes.NewReader(buf.Bytes())), opts)
+				r.Terminator = term
+				got, err := r.ReadAll()
+				if err != nil {
+					t.Fatalf("ReadAll error: %v", err)
+				}
+				if !reflect.DeepEqual(got, records) {
+					t.Errorf("ReadAll mismatch: got %d records, want %d", len(got), len(records))
+				}
+			})
+		}
+	}
+}
+
+// TestRead_InvalidTerminator tests that invalid Terminator settings are rejected.
+func TestRead_InvalidTerminator(t *testing.T) {
+	for _, term := range []Terminator{TerminatorCR + 1, TerminatorByte(','), TerminatorByte('"'), TerminatorByte(0), 0x2a0a} {
+		r := NewReader(strings.NewReader("a,b\n"))
+		r.Terminator = term
+		if _, err := r.Read(); !errors.Is(err, ErrInvalidDelim) {
+			t.Errorf("Read with Terminator %#x: got %v, want ErrInvalidDelim", term, err)
+		}
+	}
+}
+
+// =============================================================================
+// Reset and Release Tests
+// =============================================================================
+
+// TestReader_Reset verifies a Reader reads a new source after Reset, and that
+// records returned before Reset stay valid outside ZeroCopy mode.
+func TestReader_Reset(t *testing.T) {
+	first := generateWindowedCSV(3 * 4096)
+	second := "x,y\n1,2\n\"3\"\"\",4\n"
+	wantFirst := readAllStdlib(t, first)
+	wantSecond := readAllStdlib(t, second)
+
+	for _, opts := range []ReaderOptions{{}, {BufferSize: 4096}, {ZeroCopy: true, BufferSize: 4096}} {
+		t.Run(fmt.Sprintf("%+v", opts), func(t *testing.T) {
+			r := NewReaderWithOptions(strings.NewReader(first), opts)
+			r.FieldsPerRecord = -1
+			record, err := r.Read()
+			if err != nil {
+				t.Fatalf("Read error: %v", err)
+			}
+			if !opts.ZeroCopy {
+				defer func() {
+					if !reflect.DeepEqual(record, wantFirst[0]) {
+						t.Errorf("record read before Reset changed: got %q, want %q", record, wantFirst[0])
+					}
+				}()
+			}
+
+			r.Reset(strings.NewReader(second))
+			if got := r.InputOffset(); got != 0 {
+				t.Errorf("InputOffset after Reset = %d, want 0", got)
+			}
+			got, err := r.ReadAll()
+			if err != nil {
+				t.Fatalf("ReadAll after Reset error: %v", err)
+			}
+			if !reflect.DeepEqual(got, wantSecond) {
+				t.Errorf("ReadAll after Reset = %q, want %q", got, wantSecond)
+			}
+
+			r.Reset(strings.NewReader(first))
+			got, err = r.ReadAll()
+			if err != nil {
+				t.Fatalf("ReadAll after second Reset error: %v", err)
+			}
+			if !reflect.DeepEqual(got, wantFirst) {
+				t.Errorf("ReadAll after second Reset: got %d records, want %d", len(got), len(wantFirst))
+			}
+		})
+	}
+}
+
+// TestReader_ResetAllocations verifies Reset allocates less than a new Reader.
+func TestReader_ResetAllocations(t *testing.T) {
+	input := "a,b,c\n1,\"2\"\"\",3\n"
+	src := strings.NewReader(input)
+	opts := ReaderOptions{ZeroCopy: true}
+	readAll := func(r *Reader) {
+		for {
+			if _, err := r.Read(); err == io.EOF {
+				return
+			} else if err != nil {
+				t.Fatalf("Read error: %v", err)
+			}
+		}
+	}
+
+	fresh := testing.AllocsPerRun(100, func() {
+		src.Reset(input)
+		r := NewReaderWithOptions(src, opts)
+		r.ReuseRecord = true
+		readAll(r)
+	})
+
+	r := NewReaderWithOptions(src, opts)
+	r.ReuseRecord = true
+	reset := testing.AllocsPerRun(100, func() {
+		src.Reset(input)
+		r.Reset(src)
+		readAll(r)
+	})
+	if reset >= fresh {
+		t.Errorf("got %.1f allocs per Reset, want fewer than %.1f for a new Reader", reset, fresh)
+	}
+}
+
+// TestReader_Release verifies reads fail after Release until Reset.
+func TestReader_Release(t *testing.T) {
+	r := NewReader(strings.NewReader("a,b\nc,d\n"))
+	if _, err := r.Read(); err != nil {
+		t.Fatalf("Read error: %v", err)
+	}
+
+	if err := r.Close(); err != nil {
+		t.Fatalf("Close error: %v", err)
+	}
+	if _, err := r.Read(); !errors.Is(err, ErrReaderReleased) {
+		t.Fatalf("Read after Close: got %v, want ErrReaderReleased", err)
+	}
+	r.Release() // releasing twice is harmless
+
+	r.Reset(strings.NewReader("e,f\n"))
+	got, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll after Reset error: %v", err)
+	}
+	if want := [][]string{{"e", "f"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadAll after Reset = %q, want %q", got, want)
+	}
+}
+
+// =============================================================================
+// Iterator Tests
+// =============================================================================
+
+// TestReader_All verifies range over All yields the same records as ReadAll.
+func TestReader_All(t *testing.T) {
+	input := generateWindowedCSV(3 * 4096)
+	want := readAllStdlib(t, input)
+
+	for _, opts := range []ReaderOptions{{}, {BufferSize: 4096}, {ZeroCopy: true, BufferSize: 4096}} {
+		t.Run(fmt.Sprintf("%+v", opts), func(t *testing.T) {
+			r := NewReaderWithOptions(strings.NewReader(input), opts)
+			r.FieldsPerRecord = -1
+			var got [][]string
+			for record, err := range r.All() {
+				if err != nil {
+					t.Fatalf("All error: %v", err)
+				}
+				// ZeroCopy strings are only valid until the next record
+				record = slices.Clone(record)
+				for i := range record {
+					record[i] = strings.Clone(record[i])
+				}
+				got = append(got, record)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("All: got %d records, want %d", len(got), len(want))
+			}
+		})
+	}
+}
+
+// TestReader_AllErrors verifies parse errors are yielded without ending iteration.
+func TestReader_AllErrors(t *testing.T) {
+	r := NewReader(strings.NewReader("a,b\nc\nd,e\n"))
+	var records [][]string
+	var errs []error
+	for record, err := range r.All() {
+		records = append(records, record)
+		errs = append(errs, err)
+	}
+
+	want := [][]string{{"a", "b"}, {"c"}, {"d", "e"}}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("records = %q, want %q", records, want)
+	}
+	if len(errs) != 3 || errs[0] != nil || !errors.Is(errs[1], ErrFieldCount) || errs[2] != nil {
+		t.Errorf("errors = %v, want [nil ErrFieldCount nil]", errs)
+	}
+
+	r = NewReader(strings.NewReader("a\n"))
+	r.Comma = '\n'
+	count := 0
+	for _, err := range r.All() {
+		count++
+		if !errors.Is(err, ErrInvalidDelim) {
+			t.Errorf("got %v, want ErrInvalidDelim", err)
+		}
+	}
+	if count != 1 {
+		t.Errorf("invalid configuration yielded %d times, want 1", count)
+	}
+}
+
+// TestReader_AllBreak verifies reading continues after breaking out of All.
+func TestReader_AllBreak(t *testing.T) {
+	r := NewReader(strings.NewReader("a\nb\nc\nd\n"))
+	for record := range r.All() {
+		if record[0] == "b" {
+			break
+		}
+	}
+	if record, err := r.Read(); err != nil || record[0] != "c" {
+		t.Errorf("Read after break = %q, %v, want record c", record, err)
+	}
+
+	var rest []string
+	for record, err := range r.All() {
+		if err != nil {
+			t.Fatalf("All error: %v", err)
+		}
+		rest = append(rest, record[0])
+	}
+	if !reflect.DeepEqual(rest, []string{"d"}) {
+		t.Errorf("second All = %q, want [d]", rest)
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("Read at end = %v, want io.EOF", err)
+	}
+}
+
+// TestReader_AllReuseRecord verifies All does not allocate per record with ReuseRecord and ZeroCopy.
+func TestReader_AllReuseRecord(t *testing.T) {
+	src := &repeatReader{row: `1,"esc""aped",plain` + "\n", remaining: 1 << 16}
+	r := NewReaderWithOptions(src, ReaderOptions{ZeroCopy: true, BufferSize: 4096})
+	r.ReuseRecord = true
+
+	n := 0
+	allocs := testing.AllocsPerRun(1, func() {
+		for _, err := range r.All() {
+			if err != nil {
+				t.Fatalf("All error: %v", err)
+			}
+			n++
+		}
+	})
+	if perRecord := allocs / float64(n); perRecord > 0.01 {
+		t.Errorf("got %.3f allocs per record, want 0", perRecord)
+	}
+}
+
+// =============================================================================
+// Byte-Slice Record Tests
+// =============================================================================
+
+// bytesToStrings converts a byte-slice record for comparison with Read.
+func bytesToStrings(record [][]byte) []string {
+	out := make([]string, len(record))
+	for i, field := range record {
+		out[i] = string(field)
+	}
+	return out
+}
+
+// TestReadBytes_MatchesRead verifies ReadBytes yields the same fields as Read.
+func TestReadBytes_MatchesRead(t *testing.T) {
+	input := generateWindowedCSV(3*4096) + " \"trimmed \"\"q\"\"\",  x\n"
+	configs := []struct {
+		name  string
+		setup func(r *Reader)
+	}{
+		{"default", func(r *Reader) {}},
+		{"trim", func(r *Reader) { r.TrimLeadingSpace = true }},
+		{"reuse", func(r *Reader) { r.ReuseRecord = true }},
+		{"escape", func(r *Reader) { r.Escape = '\\' }},
+	}
+
+	for _, cfg := range configs {
+		for _, opts := range []ReaderOptions{{}, {BufferSize: 4096}, {ZeroCopy: true, BufferSize: 4096}} {
+			t.Run(fmt.Sprintf("%s/%+v", cfg.name, opts), func(t *testing.T) {
+				want := NewReader(strings.NewReader(input))
+				want.FieldsPerRecord = -1
+				cfg.setup(want)
+				got := NewReaderWithOptions(strings.NewReader(input), opts)
+				got.FieldsPerRecord = -1
+				cfg.setup(got)
+
+				for n := 0; ; n++ {
+					wantRecord, wantErr := want.Read()
+					gotRecord, gotErr := got.ReadBytes()
+					if fmt.Sprint(gotErr) != fmt.Sprint(wantErr) {
+						t.Fatalf("record %d: ReadBytes error %v, Read error %v", n, gotErr, wantErr)
+					}
+					if wantErr == io.EOF {
+						return
+					}
+					if s := bytesToStrings(gotRecord); !reflect.DeepEqual(s, wantRecord) {
+						t.Fatalf("record %d: ReadBytes = %q, Read = %q", n, s, wantRecord)
+					}
+					if wantErr != nil {
+						continue
+					}
+					line, col := got.FieldPos(0)
+					if wantLine, wantCol := want.FieldPos(0); line != wantLine || col != wantCol {
+						t.Fatalf("record %d: FieldPos(0) = %d:%d, want %d:%d", n, line, col, wantLine, wantCol)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestReadBytes_Isolation verifies fields are capped so appending to one
+// does not overwrite its neighbors.
+func TestReadBytes_Isolation(t *testing.T) {
+	r := NewReader(strings.NewReader("ab,cd,\"e\"\"f\",gh\n"))
+	record, err := r.ReadBytes()
+	if err != nil {
+		t.Fatalf("ReadBytes error: %v", err)
+	}
+	for i := range record {
+		_ = append(record[i], "XX"...)
+	}
+	if got, want := bytesToStrings(record), []string{"ab", "cd", `e"f`, "gh"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after append: got %q, want %q", got, want)
+	}
+}
+
+// TestReadBytes_NoAllocations verifies steady-state ReadBytes does not allocate with ReuseRecord.
+func TestReadBytes_NoAllocations(t *testing.T) {
+	src := &repeatReader{row: `1,"esc""aped",plain` + "\n", remaining: 1 << 20}
+	r := NewReaderWithOptions(src, ReaderOptions{ZeroCopy: true, BufferSize: 4096})
+	r.ReuseRecord = true
+
+	for i := 0; i < 1000; i++ {
+		if _, err := r.ReadBytes(); err != nil {
+			t.Fatalf("ReadBytes error: %v", err)
+		}
+	}
+	allocs := testing.AllocsPerRun(10000, func() {
+		if _, err := r.ReadBytes(); err != nil {
+			t.Fatalf("ReadBytes error: %v", err)
+		}
+	})
+	if allocs > 0.01 {
+		t.Errorf("got %.3f allocs per ReadBytes, want 0", allocs)
+	}
+}
+
+// =============================================================================
+// Lazy Row Tests
+// =============================================================================
+
+// TestReadRow_MatchesRead verifies Row accessors yield the same fields as Read.
+func TestReadRow_MatchesRead(t *testing.T) {
+	input := generateWindowedCSV(3*4096) + "\"quoted \"\"q\"\"\",  x\n\"multi\r\nline\",y\r\n"
+	for _, opts := range []ReaderOptions{{}, {BufferSize: 4096}, {ZeroCopy: true, BufferSize: 4096}} {
+		t.Run(fmt.Sprintf("%+v", opts), func(t *testing.T) {
+			want := NewReader(strings.NewReader(input))
+			want.FieldsPerRecord = -1
+			got := NewReaderWithOptions(strings.NewReader(input), opts)
+			got.FieldsPerRecord = -1
+
+			for n := 0; ; n++ {
+				wantRecord, wantErr := want.Read()
+				row, gotErr := got.ReadRow()
+				if gotErr != wantErr {
+					t.Fatalf("record %d: ReadRow error %v, Read error %v", n, gotErr, wantErr)
+				}
+				if wantErr == io.EOF {
+					return
+				}
+				if row.Len() != len(wantRecord) {
+					t.Fatalf("record %d: Len() = %d, want %d", n, row.Len(), len(wantRecord))
+				}
+				for i, field := range wantRecord {
+					if b := row.FieldBytes(i); string(b) != field {
+						t.Fatalf("record %d: FieldBytes(%d) = %q, want %q", n, i, b, field)
+					}
+					if s := row.Field(i); s != field {
+						t.Fatalf("record %d: Field(%d) = %q, want %q", n, i, s, field)
+					}
+				}
+				if err := row.Err(); err != nil {
+					t.Fatalf("record %d: Err() = %v", n, err)
+				}
+				line, col := got.FieldPos(row.Len() - 1)
+				if wantLine, wantCol := want.FieldPos(len(wantRecord) - 1); line != wantLine || col != wantCol {
+					t.Fatalf("record %d: FieldPos = %d:%d, want %d:%d", n, line, col, wantLine, wantCol)
+				}
+			}
+		})
+	}
+}
+
+// TestReadRow_LazyValidation verifies quote errors surface only for accessed fields.
+func TestReadRow_LazyValidation(t *testing.T) {
+	r := NewReader(strings.NewReader("ok,\"bad\"x,\"also\"\"ok\"\nnext\n"))
+	r.FieldsPerRecord = -1
+	row, err := r.ReadRow()
+	if err != nil {
+		t.Fatalf("ReadRow error: %v", err)
+	}
+	if got := row.Field(0); got != "ok" || row.Err() != nil {
+		t.Fatalf("Field(0) = %q, Err() = %v; want ok, nil", got, row.Err())
+	}
+	if got := row.Field(2); got != `also"ok` || row.Err() != nil {
+		t.Fatalf("Field(2) = %q, Err() = %v; want also\"ok, nil", got, row.Err())
+	}
+	row.Field(1)
+	if !errors.Is(row.Err(), ErrQuote) {
+		t.Fatalf("Err() after Field(1) = %v, want ErrQuote", row.Err())
+	}
+
+	row, err = r.ReadRow()
+	if err != nil || row.Field(0) != "next" || row.Err() != nil {
+		t.Fatalf("next ReadRow: err %v, Err() %v", err, row.Err())
+	}
+}
+
+// TestReadRow_RawAndQuoted tests Raw and IsQuoted.
+func TestReadRow_RawAndQuoted(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		term   Terminator
+		raw    string
+		quoted []bool
+	}{
+		{"plain", "a,b\n", TerminatorAny, "a,b", []bool{false, false}},
+		{"quoted", "\"a\"\"x\",b\n", TerminatorAny, `"a""x",b`, []bool{true, false}},
+		{"crlf", "a,\"b\"\r\nc\r\n", TerminatorAny, `a,"b"`, []bool{false, true}},
+		{"no terminator", "a,b", TerminatorAny, "a,b", []bool{false, false}},
+		{"custom", "a,\"b\";c;", TerminatorByte(';'), `a,"b"`, []bool{false, true}},
+		{"empty fields", ",\n", TerminatorAny, ",", []bool{false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReader(strings.NewReader(tt.input))
+			r.Terminator = tt.term
+			row, err := r.ReadRow()
+			if err != nil {
+				t.Fatalf("ReadRow error: %v", err)
+			}
+			if got := string(row.Raw()); got != tt.raw {
+				t.Errorf("Raw() = %q, want %q", got, tt.raw)
+			}
+			for i, want := range tt.quoted {
+				if got := row.IsQuoted(i); got != want {
+					t.Errorf("IsQuoted(%d) = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestReadRow_FieldCount verifies a field count mismatch returns the Row with ErrFieldCount.
+func TestReadRow_FieldCount(t *testing.T) {
+	r := NewReader(strings.NewReader("a,b\n# comment\nc\n"))
+	r.Comment = '#'
+	if _, err := r.ReadRow(); err != nil {
+		t.Fatalf("ReadRow error: %v", err)
+	}
+	row, err := r.ReadRow()
+	if !errors.Is(err, ErrFieldCount) {
+		t.Fatalf("ReadRow error: got %v, want ErrFieldCount", err)
+	}
+	if row == nil || row.Len() != 1 || row.Field(0) != "c" {
+		t.Fatalf("ReadRow row with ErrFieldCount: got %v", row)
+	}
+}
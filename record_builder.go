@@ -36,7 +36,7 @@ func (r *Reader) buildRecordWithValidation(row rowInfo, rowIdx int) ([]string, e
 	fields := r.getFieldsForRow(row, fieldCount)
 
 	// Fast path: check if any field needs transformation
-	needsTransform := r.state.hasCR
+	needsTransform := r.state.hasCR && r.Terminator.dropsCR()
 	if !needsTransform {
 		for _, field := range fields {
 			if field.needsUnescape() {
@@ -46,9 +46,9 @@ func (r *Reader) buildRecordWithValidation(row rowInfo, rowIdx int) ([]string, e
 		}
 	}
 
-	// Fast path: zero-copy when no transformation needed (but still validate)
+	// Fast path: one copy of the row when no transformation needed (but still validate)
 	if !needsTransform && !r.TrimLeadingSpace {
-		return r.buildRecordWithValidationZeroCopy(row, fields)
+		return r.buildRecordWithValidationRowCopy(row, fields)
 	}
 
 	// Standard path with transformation
@@ -65,44 +65,36 @@ func (r *Reader) buildRecordWithValidation(row rowInfo, rowIdx int) ([]string, e
 	return r.buildFinalRecord(fieldCount), nil
 }
 
-// buildRecordWithValidationZeroCopy builds a record with zero-copy strings while validating.
-// Zero-copy is safe here because rawBuffer outlives the returned record strings.
-func (r *Reader) buildRecordWithValidationZeroCopy(row rowInfo, fields []fieldInfo) ([]string, error) {
+// buildRecordWithValidationRowCopy builds a record whose fields need no
+// transformation while validating. The row span is copied once and each
+// field is a substring of it, so the record does not alias the window.
+func (r *Reader) buildRecordWithValidationRowCopy(row rowInfo, fields []fieldInfo) ([]string, error) {
 	fieldCount := row.fieldCount
 	record := r.allocateRecord(fieldCount)
 	r.state.fieldPositions = r.ensureFieldPositionsCapacity(fieldCount)
+	if len(fields) == 0 {
+		return record, nil
+	}
 
 	buf := r.state.rawBuffer
 	bufLen := uint32(len(buf))
+	rowStart := min(fields[0].rawStart(), bufLen)
+	rowEnd := clampUint32(fields[len(fields)-1].rawEnd(), rowStart, bufLen)
+	rowStr := r.rowString(rowStart, rowEnd)
 
 	for i, field := range fields {
 		if err := r.validateFieldIfNeeded(field, row.lineNum); err != nil {
 			return record[:i], err
 		}
 
-		record[i] = r.extractFieldString(buf, bufLen, field)
-		r.state.fieldPositions[i] = position{line: row.lineNum, column: int(field.rawStart()) + 1}
+		record[i] = r.extractFieldFromRow(buf, bufLen, rowStr, len(rowStr), rowStart, field)
+		r.state.fieldPositions[i] = position{line: row.lineNum, column: r.inputColumn(uint64(field.rawStart()))}
 	}
 	return record, nil
 }
 
-// extractFieldString returns a zero-copy string for the field content.
-// Returns empty string if field is out of bounds.
-func (r *Reader) extractFieldString(buf []byte, bufLen uint32, field fieldInfo) string {
-	start := field.start
-	end := start + field.length
-	if start >= bufLen {
-		return ""
-	}
-	if end > bufLen {
-		end = bufLen
-	}
-	return unsafe.String(&buf[start], int(end-start))
-}
-
 // buildRecordNoQuotes builds a record when the input contains no quotes.
 // Uses a single row string to avoid per-field allocations.
-// Zero-copy when TrimLeadingSpace is disabled; copies when trimming is needed.
 func (r *Reader) buildRecordNoQuotes(row rowInfo) []string {
 	fieldCount := row.fieldCount
 	record := r.allocateRecord(fieldCount)
@@ -124,7 +116,7 @@ func (r *Reader) buildRecordNoQuotes(row rowInfo) []string {
 	if rowStart >= bufLen {
 		for i, field := range fields {
 			record[i] = ""
-			r.state.fieldPositions[i] = position{line: row.lineNum, column: int(field.rawStart()) + 1}
+			r.state.fieldPositions[i] = position{line: row.lineNum, column: r.inputColumn(uint64(field.rawStart()))}
 		}
 		return record
 	}
@@ -132,18 +124,48 @@ func (r *Reader) buildRecordNoQuotes(row rowInfo) []string {
 	// Clamp row bounds
 	rowEnd = clampUint32(rowEnd, rowStart, bufLen)
 
-	// Create row string (copy if trimming, zero-copy otherwise)
-	rowStr := r.createRowString(buf, rowStart, rowEnd)
+	rowStr := r.rowString(rowStart, rowEnd)
 	rowStrLen := len(rowStr)
 
 	// Extract fields from row string
 	for i, field := range fields {
 		record[i] = r.extractFieldFromRow(buf, bufLen, rowStr, rowStrLen, rowStart, field)
-		r.state.fieldPositions[i] = position{line: row.lineNum, column: int(field.rawStart()) + 1}
+		r.state.fieldPositions[i] = position{line: row.lineNum, column: r.inputColumn(uint64(field.rawStart()))}
 	}
 	return record
 }
 
+// rowString returns a copy of the row span of the window, which is reused for
+// the next records. ReadAll keeps every record, so there the records of a
+// window share one copy of it instead of allocating one each.
+func (r *Reader) rowString(rowStart, rowEnd uint32) string {
+	if !r.state.retainWindows {
+		return string(r.state.rawBuffer[rowStart:rowEnd])
+	}
+	if r.state.windowCopy == "" {
+		r.state.windowCopy = r.copyWindow()
+	}
+	return r.state.windowCopy[rowStart:rowEnd]
+}
+
+// copyWindow copies the window for the records of ReadAll. Copies are carved
+// from one allocation sized for the rest of the input, like the records, so
+// ReadAll allocates up front rather than with every window. The copied bytes
+// are never written again.
+func (r *Reader) copyWindow() string {
+	buf := r.state.rawBuffer
+	if len(buf) == 0 {
+		return ""
+	}
+	if len(r.state.recordText) < len(buf) {
+		r.state.recordText = make([]byte, len(buf)+int(max(r.state.sourceSize-r.state.bytesRead, 0)))
+	}
+	n := copy(r.state.recordText, buf)
+	s := unsafe.String(&r.state.recordText[0], n)
+	r.state.recordText = r.state.recordText[n:]
+	return s
+}
+
 // clampUint32 clamps value to [minVal, maxVal].
 func clampUint32(value, minVal, maxVal uint32) uint32 {
 	if value < minVal {
@@ -155,15 +177,6 @@ func clampUint32(value, minVal, maxVal uint32) uint32 {
 	return value
 }
 
-// createRowString creates a string for the row span.
-// Copies when TrimLeadingSpace is enabled; zero-copy otherwise.
-func (r *Reader) createRowString(buf []byte, rowStart, rowEnd uint32) string {
-	if r.TrimLeadingSpace {
-		return string(buf[rowStart:rowEnd])
-	}
-	return unsafe.String(&buf[rowStart], int(rowEnd-rowStart))
-}
-
 // extractFieldFromRow extracts a field string from the row string.
 func (r *Reader) extractFieldFromRow(buf []byte, bufLen uint32, rowStr string, rowStrLen int, rowStart uint32, field fieldInfo) string {
 	start := field.start
@@ -216,7 +229,7 @@ func (r *Reader) processField(field fieldInfo, fieldIdx, lineNum int) {
 
 	r.state.fieldPositions[fieldIdx] = position{
 		line:   lineNum,
-		column: int(rawStart) + 1, //nolint:gosec // G115: rawStart bounded by buffer size
+		column: r.inputColumn(rawStart),
 	}
 }
 
@@ -239,6 +252,87 @@ func (r *Reader) validateFieldIfNeeded(field fieldInfo, lineNum int) error {
 	return r.validateFieldQuotesWithField(field, rawStart, rawEnd, lineNum)
 }
 
+// ============================================================================
+// Record Building - ZeroCopy Mode
+// ============================================================================
+
+// buildRecordZeroCopyMode builds a record for ReaderOptions.ZeroCopy.
+// Each field is decided individually: untransformed fields alias rawBuffer and
+// transformed fields alias fieldArena, so no per-record string is allocated.
+func (r *Reader) buildRecordZeroCopyMode(row rowInfo) ([]string, error) {
+	fieldCount := row.fieldCount
+	fields := r.getFieldsForRow(row, fieldCount)
+	record := r.allocateRecord(fieldCount)
+	r.state.fieldPositions = r.ensureFieldPositionsCapacity(fieldCount)
+
+	for i, field := range fields {
+		if err := r.validateFieldIfNeeded(field, row.lineNum); err != nil {
+			return record[:i], err
+		}
+
+		record[i] = r.fieldStringZeroCopy(field)
+		r.state.fieldPositions[i] = position{line: row.lineNum, column: r.inputColumn(uint64(field.rawStart()))}
+	}
+	return record, nil
+}
+
+// fieldStringZeroCopy returns the field value, copying only when a transformation is required.
+func (r *Reader) fieldStringZeroCopy(field fieldInfo) string {
+	content, transform := r.fieldContent(field)
+	if transform {
+		return r.arenaString(content)
+	}
+	if len(content) == 0 {
+		return ""
+	}
+	return unsafe.String(&content[0], len(content))
+}
+
+// fieldContent returns the content of field within rawBuffer, after
+// TrimLeadingSpace, and whether it must be unescaped or CRLF-normalized before use.
+func (r *Reader) fieldContent(field fieldInfo) ([]byte, bool) {
+	if r.state.hasQuotes && r.TrimLeadingSpace {
+		if content, ok := r.trimmedQuotedContent(uint64(field.rawStart())); ok {
+			return content, true
+		}
+	}
+
+	content := r.getFieldContentWithTrim(field)
+	if len(content) == 0 {
+		return nil, false
+	}
+	// needsUnescape is marked per 64-byte chunk, so confirm against the content before copying
+	transform := (r.state.hasQuotes || r.state.hasEscapes) && r.needsContentTransform(field, content) &&
+		(hasTransformableBytes(content, r.quoteByte()) ||
+			r.state.hasEscapes && bytes.IndexByte(content, r.escapeByte()) >= 0)
+	return content, transform
+}
+
+// hasTransformableBytes reports whether transformContent could change content.
+func hasTransformableBytes(content []byte, quote byte) bool {
+	return bytes.IndexByte(content, quote) >= 0 || bytes.IndexByte(content, '\r') >= 0
+}
+
+// arenaString appends the unescaped, CRLF-normalized content to fieldArena
+// and returns a string aliasing the appended bytes.
+func (r *Reader) arenaString(content []byte) string {
+	start := len(r.state.fieldArena)
+	r.state.fieldArena = r.appendTransformed(r.state.fieldArena, content)
+	if len(r.state.fieldArena) == start {
+		return ""
+	}
+	return unsafe.String(&r.state.fieldArena[start], len(r.state.fieldArena)-start)
+}
+
+// appendTransformed appends content to dst with quotes unescaped, CRLF
+// normalized and, if the window has escapes, escape sequences decoded.
+func (r *Reader) appendTransformed(dst, content []byte) []byte {
+	if r.state.hasEscapes {
+		return appendUnescaped(dst, content, r.quoteByte(), r.escapeByte(), r.Terminator.dropsCR())
+	}
+	return transformContent(content, dst, r.quoteByte(), r.Terminator.dropsCR())
+}
+
 // ============================================================================
 // Record Building - Output Construction
 // ============================================================================
@@ -279,7 +373,7 @@ func (r *Reader) buildPartialRecord(errorFieldIdx int) []string {
 func (r *Reader) appendFieldContent(field fieldInfo, rawStart, rawEnd uint64) {
 	// Fast path: no quotes in entire input means no unescape/CRLF handling needed.
 	// CRLF inside fields only occurs in quoted fields, so hasQuotes=false implies no field-internal CRLF.
-	if !r.state.hasQuotes {
+	if !r.state.hasQuotes && !r.state.hasEscapes {
 		r.appendSimpleContent(field)
 		return
 	}
@@ -314,25 +408,35 @@ func (r *Reader) appendSimpleContent(field fieldInfo) {
 // tryAppendTrimmedQuotedField handles TrimLeadingSpace for quoted fields.
 // Returns true if the field was processed, false if standard processing should continue.
 func (r *Reader) tryAppendTrimmedQuotedField(rawStart uint64) bool {
-	if rawStart >= uint64(len(r.state.rawBuffer)) {
+	content, ok := r.trimmedQuotedContent(rawStart)
+	if !ok {
 		return false
 	}
+	r.appendContentWithTransform(content)
+	return true
+}
+
+// trimmedQuotedContent returns the content between quotes of a quoted field
+// preceded by whitespace. Returns false if the field is not of that form.
+func (r *Reader) trimmedQuotedContent(rawStart uint64) ([]byte, bool) {
+	if rawStart >= uint64(len(r.state.rawBuffer)) {
+		return nil, false
+	}
 
+	quote := r.quoteByte()
 	raw := r.state.rawBuffer[rawStart:]
-	isQuoted, quoteOffset := isQuotedFieldStart(raw, true)
+	isQuoted, quoteOffset := isQuotedFieldStart(raw, true, quote)
 	if !isQuoted || quoteOffset == 0 {
-		return false
+		return nil, false
 	}
 
 	quotedData := raw[quoteOffset:]
-	closingQuoteIdx := findClosingQuote(quotedData, 1)
+	closingQuoteIdx := r.closingQuoteIndex(quotedData, 1)
 	if closingQuoteIdx <= 0 {
-		return false
+		return nil, false
 	}
 
-	content := quotedData[1:closingQuoteIdx]
-	r.appendContentWithTransform(content)
-	return true
+	return quotedData[1:closingQuoteIdx], true
 }
 
 // getFieldContentWithTrim returns field content with optional leading space trimming.
@@ -351,7 +455,7 @@ func (r *Reader) needsContentTransform(field fieldInfo, content []byte) bool {
 	}
 
 	isQuoted := field.flags&fieldFlagIsQuoted != 0
-	hasCRLF := isQuoted && r.state.hasCR && containsCRLFBytes(content)
+	hasCRLF := isQuoted && r.state.hasCR && r.Terminator.dropsCR() && containsCRLFBytes(content)
 	return hasCRLF
 }
 
@@ -359,20 +463,27 @@ func (r *Reader) needsContentTransform(field fieldInfo, content []byte) bool {
 // Content Transformation - Unescape and CRLF Normalization
 // ============================================================================
 
-// appendContentWithTransform appends content with inline double-quote unescape and CRLF normalization.
+// appendContentWithTransform appends content with inline doubled-quote unescape and CRLF normalization.
+// If the window has escapes, escape sequences are decoded as well.
 func (r *Reader) appendContentWithTransform(content []byte) {
+	quote := r.quoteByte()
+	if r.state.hasEscapes {
+		r.state.recordBuffer = appendUnescaped(r.state.recordBuffer, content, quote, r.escapeByte(), r.Terminator.dropsCR())
+		return
+	}
+	crlf := r.Terminator.dropsCR() // LF and CR are field data under the other terminators
 	for i := 0; i < len(content); i++ {
 		b := content[i]
 
 		// Check for escaped quote: "" -> "
-		if b == '"' && i+1 < len(content) && content[i+1] == '"' {
-			r.state.recordBuffer = append(r.state.recordBuffer, '"')
+		if b == quote && i+1 < len(content) && content[i+1] == quote {
+			r.state.recordBuffer = append(r.state.recordBuffer, quote)
 			i++ // skip next quote
 			continue
 		}
 
 		// Check for CRLF: \r\n -> \n
-		if b == '\r' && i+1 < len(content) && content[i+1] == '\n' {
+		if crlf && b == '\r' && i+1 < len(content) && content[i+1] == '\n' {
 			r.state.recordBuffer = append(r.state.recordBuffer, '\n')
 			i++ // skip \n
 			continue
@@ -429,6 +540,16 @@ func (r *Reader) allocateRecord(fieldCount int) []string {
 		r.state.lastRecord = r.state.lastRecord[:fieldCount]
 		return r.state.lastRecord
 	}
+	if r.state.retainWindows && !r.ReuseRecord {
+		// ReadAll keeps every record, so records are carved from one
+		// allocation sized for the rest of the input
+		if len(r.state.recordSlab) < fieldCount {
+			r.state.recordSlab = make([]string, max(r.slabSize(), fieldCount))
+		}
+		record := r.state.recordSlab[:fieldCount:fieldCount]
+		r.state.recordSlab = r.state.recordSlab[fieldCount:]
+		return record
+	}
 	record := make([]string, fieldCount)
 	if r.ReuseRecord {
 		r.state.lastRecord = record
@@ -436,6 +557,17 @@ func (r *Reader) allocateRecord(fieldCount int) []string {
 	return record
 }
 
+// slabSize returns the number of fields to allocate for the records of
+// ReadAll: those of the current window, scaled to the rest of the input when
+// its size is known.
+func (r *Reader) slabSize() int {
+	n := len(r.state.parseResult.fields)
+	if rest := r.state.sourceSize - r.state.bytesRead; rest > 0 && len(r.state.rawBuffer) > 0 {
+		n += int(int64(n) * rest / int64(len(r.state.rawBuffer)))
+	}
+	return n
+}
+
 // ============================================================================
 // Buffer Management
 // ============================================================================
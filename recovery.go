@@ -0,0 +1,153 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"errors"
+	"fmt"
+)
+
+// =============================================================================
+// Error Recovery
+// =============================================================================
+//
+// Record boundaries are found by the scanner before any field is validated,
+// so a malformed record never disturbs its neighbours: skipping it means
+// moving on to the next parsed row. The only damage a stray quote can do is
+// in the scan itself, where it may join the following lines into one record;
+// that record then fails as a whole and the reader resumes after it.
+//
+// =============================================================================
+
+// ErrorPolicy selects what a Reader does with a malformed record, one with a
+// quote error or the wrong number of fields.
+type ErrorPolicy int
+
+const (
+	// OnErrorFail returns the error from the read call (default).
+	OnErrorFail ErrorPolicy = iota
+
+	// OnErrorSkip drops the record and continues with the next one.
+	OnErrorSkip
+
+	// OnErrorCallback passes the error to ReaderOptions.ErrorHandler,
+	// which decides whether the record is dropped.
+	OnErrorCallback
+)
+
+// SkippedErrors returns the errors of the records dropped so far under
+// ReaderOptions.OnError, in input order. Each locates the record by line and
+// column. The slice is retained by the Reader until Reset; set MaxErrors to
+// bound it.
+func (r *Reader) SkippedErrors() []*ParseError {
+	return r.state.skippedErrors
+}
+
+// RawRecord returns the most recently read record exactly as it appears in
+// the input, including embedded line breaks and its terminator, if any.
+// Inside ErrorHandler it is the malformed record, cut at the line where
+// reading resumes. The slice is valid until the next call to any read method,
+// Reset or Release, and must not be modified.
+func (r *Reader) RawRecord() []byte {
+	raw := r.state.rawBuffer
+	start, end := r.state.recordStart-r.state.windowBase, r.state.recordEnd-r.state.windowBase
+	if start < 0 || end > int64(len(raw)) || start >= end {
+		return nil
+	}
+	return raw[start:end:end]
+}
+
+// skipRecord reports whether a record that failed with err is dropped under
+// the OnError policy. If it is not, skipRecord returns the error to report.
+func (r *Reader) skipRecord(err error) (bool, error) {
+	var parseErr *ParseError
+	if r.opts.onError == OnErrorFail || !errors.As(err, &parseErr) || !isRecordError(parseErr.Err) {
+		return false, err
+	}
+	// An indexed window cannot be rescanned, so the record is dropped as scanned
+	if !r.state.indexed && (errors.Is(parseErr.Err, ErrQuote) || errors.Is(parseErr.Err, ErrBareQuote)) {
+		r.resync(parseErr.Offset)
+	}
+
+	if r.opts.maxErrors > 0 && len(r.state.skippedErrors) >= r.opts.maxErrors {
+		// Sticky: reading does not go on past the budget
+		r.state.inputErr = fmt.Errorf("%w: %w", ErrTooManyErrors, err)
+		return false, r.state.inputErr
+	}
+	if r.opts.onError == OnErrorCallback && r.opts.errorHandler != nil {
+		if err := r.opts.errorHandler(parseErr); err != nil {
+			return false, err
+		}
+	}
+	if r.opts.quarantine != nil {
+		if _, err := r.opts.quarantine.Write(r.RawRecord()); err != nil {
+			return false, fmt.Errorf("simdcsv: writing quarantine: %w", err)
+		}
+	}
+	r.state.skippedErrors = append(r.state.skippedErrors, parseErr)
+	return true, nil
+}
+
+// resync rescans the input after the line holding a quote error at the given
+// input offset, if that line is not the end of the dropped record. A quote left
+// open at the end of the record restarts after the line where its field begins.
+func (r *Reader) resync(offset int64) {
+	row := r.state.parseResult.rows[r.state.currentRecordIndex-1]
+	fields := r.getFieldsForRow(row, row.fieldCount)
+	if len(fields) == 0 {
+		return
+	}
+	start, end := int(fields[0].rawStart()), int(fields[len(fields)-1].rawEnd())
+	pos := int(offset - r.state.windowBase)
+	if pos < start || pos >= end {
+		return
+	}
+
+	if pos == end-1 {
+		// Unterminated quote: the record ran to its last byte looking for it
+		for _, field := range fields {
+			if int(field.rawStart()) <= pos {
+				pos = int(field.rawStart())
+			}
+		}
+	}
+	// Include the record's own terminator so a CRLF is not taken for a lone CR
+	raw := r.state.rawBuffer
+	next := r.Terminator.nextTerminator(raw[pos:min(end+1, len(raw))])
+	if next < 0 || pos+next > end {
+		return
+	}
+	r.restartAt(row, start, pos+next)
+}
+
+// restartAt ends the current window at resume, a line start inside row, which
+// begins at start. The rest of the window is scanned again as the head of the
+// next one.
+func (r *Reader) restartAt(row rowInfo, start, resume int) {
+	raw := r.state.rawBuffer
+	lines := 0
+	for rest := raw[start:resume]; ; lines++ {
+		n := r.Terminator.nextTerminator(rest)
+		if n < 0 {
+			break
+		}
+		rest = rest[n:]
+	}
+	// Lines before the record, and those of the record up to resume
+	r.state.lineCount = row.lineNum - 1 + lines - r.state.lineBase
+	r.state.pending = raw[resume : len(raw)+len(r.state.pending)]
+	r.state.rawBuffer = raw[:resume]
+	r.state.resynced = true
+	// The scan of the rest of the window assumed the quote state of the record
+	r.state.scanResult.release()
+	r.state.scanResult = nil
+	r.state.recordEnd = r.inputOffset(uint64(resume)) //nolint:gosec // G115: window positions are non-negative
+	r.state.inputOffset = r.state.recordEnd
+	// Drop the rows after the record, keeping it last for RawRecord
+	r.state.parseResult.rows = r.state.parseResult.rows[:r.state.currentRecordIndex]
+}
+
+// isRecordError reports whether err is confined to one record.
+func isRecordError(err error) bool {
+	return errors.Is(err, ErrQuote) || errors.Is(err, ErrBareQuote) || errors.Is(err, ErrFieldCount)
+}
@@ -0,0 +1,275 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// =============================================================================
+// Error Recovery Tests
+// =============================================================================
+
+// dirtyCSV has a bare quote swallowing the following lines, a field count
+// mismatch, a quoted field with trailing bytes, and a quote left open.
+const dirtyCSV = "a,b,c\nd,e\"f,g\nh,i,j\nk,l\nm,\"n\"x,o\np,q,r\ns,\"t,u\nv,w,x\n"
+
+var dirtyRecords = [][]string{{"a", "b", "c"}, {"h", "i", "j"}, {"p", "q", "r"}, {"v", "w", "x"}}
+
+// TestOnError_Skip verifies every read method drops the same malformed records.
+func TestOnError_Skip(t *testing.T) {
+	readers := map[string]func(r *Reader) ([][]string, error){
+		"Read": func(r *Reader) ([][]string, error) {
+			return r.ReadAll()
+		},
+		"ReadBytes": func(r *Reader) ([][]string, error) {
+			var records [][]string
+			for {
+				fields, err := r.ReadBytes()
+				if err != nil {
+					return records, err
+				}
+				record := make([]string, len(fields))
+				for i, f := range fields {
+					record[i] = string(f)
+				}
+				records = append(records, record)
+			}
+		},
+		"ReadRow": func(r *Reader) ([][]string, error) {
+			var records [][]string
+			for {
+				row, err := r.ReadRow()
+				if err != nil {
+					return records, err
+				}
+				record := make([]string, row.Len())
+				for i := range record {
+					record[i] = row.Field(i)
+				}
+				records = append(records, record)
+			}
+		},
+		"ReadBatch": func(r *Reader) ([][]string, error) {
+			var records [][]string
+			for {
+				b, err := r.ReadBatch(2)
+				if err != nil {
+					return records, err
+				}
+				records = append(records, batchRows(b)...)
+			}
+		},
+	}
+	for name, read := range readers {
+		t.Run(name, func(t *testing.T) {
+			r := NewReaderWithOptions(strings.NewReader(dirtyCSV), ReaderOptions{OnError: OnErrorSkip})
+			got, err := read(r)
+			if err != nil && err != io.EOF {
+				t.Fatalf("error: %v", err)
+			}
+			if !reflect.DeepEqual(got, dirtyRecords) {
+				t.Errorf("records = %q, want %q", got, dirtyRecords)
+			}
+
+			var lines []int
+			for _, e := range r.SkippedErrors() {
+				lines = append(lines, e.Line)
+			}
+			if want := []int{2, 4, 5, 7}; !reflect.DeepEqual(lines, want) {
+				t.Errorf("skipped error lines = %v, want %v", lines, want)
+			}
+		})
+	}
+}
+
+// TestOnError_Resync verifies records after a stray quote are recovered across windows.
+func TestOnError_Resync(t *testing.T) {
+	clean := generateWindowedCSV(4 * 4096)
+	want, err := NewReader(strings.NewReader(clean)).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll error: %v", err)
+	}
+
+	// Insert a bare-quote line every 50 records
+	var b strings.Builder
+	lines := strings.SplitAfter(clean, "\n")
+	bad := 0
+	for i, line := range lines {
+		if i%50 == 25 && strings.Count(line, "\"") == 0 && strings.HasSuffix(line, "\n") {
+			b.WriteString("bad,bare\"quote,here\n")
+			bad++
+		}
+		b.WriteString(line)
+	}
+
+	for _, opts := range []ReaderOptions{
+		{OnError: OnErrorSkip, BufferSize: 4096},
+		{OnError: OnErrorSkip, BufferSize: 4096, ZeroCopy: true},
+		{OnError: OnErrorSkip},
+	} {
+		r := NewReaderWithOptions(strings.NewReader(b.String()), opts)
+		var got [][]string
+		for {
+			record, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Read error: %v", err)
+			}
+			for i := range record {
+				record[i] = strings.Clone(record[i])
+			}
+			got = append(got, record)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%+v: got %d records, want %d", opts, len(got), len(want))
+		}
+		if n := len(r.SkippedErrors()); n != bad {
+			t.Errorf("%+v: skipped %d records, want %d", opts, n, bad)
+		}
+	}
+}
+
+// TestOnError_Callback tests the handler's decision and MaxErrors.
+func TestOnError_Callback(t *testing.T) {
+	errStop := errors.New("stop")
+	var seen []string
+	r := NewReaderWithOptions(strings.NewReader(dirtyCSV), ReaderOptions{
+		OnError: OnErrorCallback,
+		ErrorHandler: func(err *ParseError) error {
+			seen = append(seen, fmt.Sprintf("%d:%v", err.Line, err.Err))
+			if errors.Is(err, ErrFieldCount) {
+				return errStop
+			}
+			return nil
+		},
+	})
+
+	got, err := r.ReadAll()
+	if !errors.Is(err, errStop) {
+		t.Fatalf("ReadAll error = %v, want errStop", err)
+	}
+	if want := dirtyRecords[:2]; !reflect.DeepEqual(got, want) {
+		t.Errorf("records = %q, want %q", got, want)
+	}
+	if want := []string{"2:" + ErrQuote.Error(), "4:" + ErrFieldCount.Error() + ": expected 3, got 2"}; !reflect.DeepEqual(seen, want) {
+		t.Errorf("handler saw %q, want %q", seen, want)
+	}
+
+	r = NewReaderWithOptions(strings.NewReader(dirtyCSV), ReaderOptions{OnError: OnErrorSkip, MaxErrors: 2})
+	got, err = r.ReadAll()
+	var parseErr *ParseError
+	if !errors.Is(err, ErrTooManyErrors) || !errors.As(err, &parseErr) || parseErr.Line != 5 {
+		t.Fatalf("ReadAll error = %v, want ErrTooManyErrors at line 5", err)
+	}
+	if want := dirtyRecords[:2]; !reflect.DeepEqual(got, want) {
+		t.Errorf("records = %q, want %q", got, want)
+	}
+}
+
+// TestOnError_MaxErrors tests that exceeding MaxErrors stops every later read.
+func TestOnError_MaxErrors(t *testing.T) {
+	opts := ReaderOptions{OnError: OnErrorSkip, MaxErrors: 2}
+	r := NewReaderWithOptions(strings.NewReader(dirtyCSV), opts)
+	if _, err := r.ReadAll(); !errors.Is(err, ErrTooManyErrors) {
+		t.Fatalf("ReadAll error = %v, want ErrTooManyErrors", err)
+	}
+	for range 2 {
+		if record, err := r.Read(); !errors.Is(err, ErrTooManyErrors) {
+			t.Errorf("Read after budget = %q, %v, want ErrTooManyErrors", record, err)
+		}
+	}
+
+	r = NewReaderWithOptions(strings.NewReader(dirtyCSV), opts)
+	var got [][]string
+	var errs []error
+	for record, err := range r.All() {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		got = append(got, record)
+	}
+	if want := dirtyRecords[:2]; !reflect.DeepEqual(got, want) {
+		t.Errorf("All records = %q, want %q", got, want)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], ErrTooManyErrors) {
+		t.Errorf("All errors = %v, want one ErrTooManyErrors", errs)
+	}
+}
+
+// TestOnError_Fail tests the default policy and errors that are never skipped.
+func TestOnError_Fail(t *testing.T) {
+	r := NewReader(strings.NewReader(dirtyCSV))
+	if _, err := r.ReadAll(); !errors.Is(err, ErrQuote) {
+		t.Errorf("ReadAll error = %v, want ErrQuote", err)
+	}
+
+	r = NewReaderWithOptions(strings.NewReader("id,id\n1,2\n"), ReaderOptions{Header: true, OnError: OnErrorSkip})
+	if _, err := r.Read(); !errors.Is(err, ErrDuplicateHeader) {
+		t.Errorf("Read error = %v, want ErrDuplicateHeader", err)
+	}
+
+	r = NewReaderWithOptions(strings.NewReader("\"a\"x,b\n1,2\n"), ReaderOptions{Header: true, OnError: OnErrorSkip})
+	if _, err := r.Read(); !errors.Is(err, ErrQuote) {
+		t.Errorf("Read error = %v, want ErrQuote from the header", err)
+	}
+}
+
+// TestQuarantine verifies dropped records are copied byte for byte, cut where reading resumes.
+func TestQuarantine(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"LF", dirtyCSV, "d,e\"f,g\nk,l\nm,\"n\"x,o\ns,\"t,u\n"},
+		{"CRLF", "a,b\r\n\"multi\r\nline\"x,c\r\nd,e,f\r\ng,h\"i\r\nj,k\r\n", "\"multi\r\nline\"x,c\r\nd,e,f\r\ng,h\"i\r\n"},
+		{"NoTerminator", "a,b\nc,\"d", "c,\"d"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var quarantine bytes.Buffer
+			var raw []string
+			var r *Reader
+			r = NewReaderWithOptions(strings.NewReader(tt.input), ReaderOptions{
+				OnError:    OnErrorCallback,
+				Quarantine: &quarantine,
+				ErrorHandler: func(*ParseError) error {
+					raw = append(raw, string(r.RawRecord()))
+					return nil
+				},
+			})
+			if _, err := r.ReadAll(); err != nil {
+				t.Fatalf("ReadAll error: %v", err)
+			}
+			if got := quarantine.String(); got != tt.want {
+				t.Errorf("quarantine = %q, want %q", got, tt.want)
+			}
+			if got := strings.Join(raw, ""); got != tt.want {
+				t.Errorf("RawRecord in handler = %q, want %q", got, tt.want)
+			}
+			if n := len(r.SkippedErrors()); n != len(raw) {
+				t.Errorf("SkippedErrors has %d entries, want %d", n, len(raw))
+			}
+		})
+	}
+
+	r := NewReader(strings.NewReader("a,\"b\r\nc\"\r\nd,e"))
+	for _, want := range []string{"a,\"b\r\nc\"\r\n", "d,e"} {
+		if _, err := r.Read(); err != nil {
+			t.Fatalf("Read error: %v", err)
+		}
+		if got := string(r.RawRecord()); got != want {
+			t.Errorf("RawRecord() = %q, want %q", got, want)
+		}
+	}
+}
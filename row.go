@@ -0,0 +1,191 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+// =============================================================================
+// Lazy Row
+// =============================================================================
+//
+// ReadRow returns the parsed field boundaries of a record without building
+// any field values. Each accessor unescapes and validates only the field it
+// is asked for, so reading a few columns of a wide record costs little more
+// than locating the record.
+//
+// =============================================================================
+
+// Row is a record whose fields are decoded on demand, returned by Reader.ReadRow.
+//
+// Quote validation runs when a field is accessed. A field failing validation
+// is returned as LazyQuotes would parse it, and Err reports the first such
+// error. Accessors panic if i is out of range.
+//
+// A Row and the values it returns follow the lifetime rules of the Reader:
+// the Row itself, FieldBytes and Raw are valid only until the next call to any
+// read method, Reset or Release. Field strings are valid as long as strings
+// returned by Read would be.
+type Row struct {
+	r       *Reader
+	fields  []fieldInfo
+	lineNum int
+	err     error
+}
+
+// ReadRow reads the next record without decoding its fields.
+// Comment lines are skipped and FieldsPerRecord is enforced as for Read;
+// a field count mismatch returns the Row together with ErrFieldCount.
+// FieldPos reports the positions of the Row's fields. Unless OnError is
+// OnErrorFail, every field is validated before the Row is returned, so that
+// malformed records can be dropped.
+func (r *Reader) ReadRow() (*Row, error) {
+	if err := r.ensureInitialized(); err != nil {
+		return nil, err
+	}
+
+	for {
+		row, _, err := r.nextRow()
+		if err != nil {
+			return nil, err
+		}
+
+		fields := r.getFieldsForRow(row, row.fieldCount)
+		r.state.row = Row{r: r, fields: fields, lineNum: row.lineNum}
+		r.state.recordBuffer = r.state.recordBuffer[:0]
+		r.state.fieldPositions = r.ensureFieldPositionsCapacity(len(fields))
+		for i, field := range fields {
+			r.state.fieldPositions[i] = position{line: row.lineNum, column: r.inputColumn(uint64(field.rawStart()))}
+		}
+
+		if r.opts.onError != OnErrorFail {
+			// Malformed records can only be dropped before they are returned
+			err = r.state.row.validateAll()
+		}
+		if err == nil {
+			err = r.finishRecord(len(fields), row)
+		}
+		if err == nil {
+			return &r.state.row, nil
+		}
+		if skip, err := r.skipRecord(err); !skip {
+			return &r.state.row, err
+		}
+	}
+}
+
+// Len returns the number of fields in the row.
+func (row *Row) Len() int {
+	return len(row.fields)
+}
+
+// Field returns the value of field i, unescaping it if needed.
+func (row *Row) Field(i int) string {
+	r := row.r
+	field, _ := row.validated(i)
+	content, transform := r.fieldContent(field)
+	if transform {
+		if r.opts.zeroCopy {
+			return r.arenaString(content)
+		}
+		// Transform at the end of the scratch buffer so FieldBytes results stay intact
+		start := len(r.state.recordBuffer)
+		r.state.recordBuffer = r.appendTransformed(r.state.recordBuffer, content)
+		s := string(r.state.recordBuffer[start:])
+		r.state.recordBuffer = r.state.recordBuffer[:start]
+		return s
+	}
+	if len(content) == 0 {
+		return ""
+	}
+	if !r.opts.zeroCopy {
+		// The window is reused for the next records
+		return string(content)
+	}
+	return unsafe.String(&content[0], len(content))
+}
+
+// FieldBytes returns the value of field i as a byte slice, unescaping it if needed.
+// The slice must not be modified.
+func (row *Row) FieldBytes(i int) []byte {
+	field, _ := row.validated(i)
+	return row.r.fieldBytes(field)
+}
+
+// IsQuoted reports whether field i is enclosed in quotes in the input.
+func (row *Row) IsQuoted(i int) bool {
+	return row.fields[i].flags&fieldFlagIsQuoted != 0
+}
+
+// IsNull reports whether field i is NULL, an unquoted \N with Escape set.
+// Field returns "" for it, as for an empty field.
+func (row *Row) IsNull(i int) bool {
+	return row.r.isNullField(row.fields[i])
+}
+
+// Raw returns the bytes of the record as they appear in the input, without
+// the record terminator. The slice must not be modified.
+func (row *Row) Raw() []byte {
+	if len(row.fields) == 0 {
+		return nil
+	}
+	buf := row.r.state.rawBuffer
+	start := row.fields[0].rawStart()
+	end := min(row.fields[len(row.fields)-1].rawEnd(), uint32(len(buf)))
+	if start >= end {
+		return nil
+	}
+	// A CR before the LF belongs to the terminator
+	if int(end) < len(buf) && row.r.Terminator.dropsCR() && buf[end] == '\n' && buf[end-1] == '\r' {
+		end--
+	}
+	return buf[start:end:end]
+}
+
+// Err returns the first quote validation error of the fields accessed so far.
+func (row *Row) Err() error {
+	return row.err
+}
+
+// fieldValue returns field i like Field, together with the quote validation
+// error of the row so far. In ZeroCopy mode the value is copied, so it stays
+// valid after the next read. It serves decoders that store values.
+func (row *Row) fieldValue(i int) (string, error) {
+	s := row.Field(i)
+	if row.r.opts.zeroCopy {
+		s = strings.Clone(s)
+	}
+	return s, row.err
+}
+
+// fieldError returns the error of converting field i into the struct field
+// named field of type typ: a ParseError at the position of the field,
+// wrapping a DecodeError.
+func (row *Row) fieldError(i int, field string, typ reflect.Type, err error) error {
+	value, _ := row.fieldValue(i)
+	return row.r.fieldErrorAt(i, &DecodeError{Field: field, Type: typ, Value: value, Err: err})
+}
+
+// validateAll validates the quotes of every field, returning the first error.
+func (row *Row) validateAll() error {
+	for i := range row.fields {
+		if _, err := row.validated(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validated returns field i and the error of validating its quotes,
+// which is also recorded for Err.
+func (row *Row) validated(i int) (fieldInfo, error) {
+	field := row.fields[i]
+	err := row.r.validateFieldIfNeeded(field, row.lineNum)
+	if err != nil && row.err == nil {
+		row.err = err
+	}
+	return field, err
+}
@@ -3,8 +3,10 @@
 package simdcsv
 
 import (
+	"bytes"
 	"math/bits"
 	"simd/archsimd"
+	"slices"
 	"sync"
 	"unsafe"
 )
@@ -21,13 +23,13 @@ func bytesToInt8Slice(b []byte) []int8 {
 // useAVX512 indicates whether AVX-512 instructions are available at runtime.
 var useAVX512 bool
 
-// Cached broadcast values for fixed characters and separators (initialized in init()).
+// Cached broadcast values for fixed characters, separators and quotes (initialized in init()).
+// cachedSepCmp holds every byte value, so it also serves the configurable quote character.
 var (
 	// AVX-512 (64-byte) cached values
-	cachedQuoteCmp archsimd.Int8x64
-	cachedCrCmp    archsimd.Int8x64
-	cachedNlCmp    archsimd.Int8x64
-	cachedSepCmp   [cachedSepCmpCount]archsimd.Int8x64
+	cachedCrCmp  archsimd.Int8x64
+	cachedNlCmp  archsimd.Int8x64
+	cachedSepCmp [cachedSepCmpCount]archsimd.Int8x64
 
 	// PCLMULQDQ cached value: all-ones for carryless multiplication
 	cachedAllOnes archsimd.Uint64x2
@@ -50,9 +52,10 @@ func init() {
 			// #nosec G115 -- i is bounded [0,255], intentional two's-complement mapping.
 			cachedSepCmp[i] = archsimd.BroadcastInt8x64(int8(i))
 		}
-		cachedQuoteCmp = cachedSepCmp['"']
 		cachedCrCmp = cachedSepCmp['\r']
 		cachedNlCmp = cachedSepCmp['\n']
+		cachedDigitLow = archsimd.BroadcastInt8x64('0' - 1)
+		cachedDigitHigh = archsimd.BroadcastInt8x64('9' + 1)
 
 		// Pre-load all-ones value for carryless multiplication (PCLMULQDQ)
 		// Used in prefixXOR: mask × 0xFFFFFFFFFFFFFFFF computes prefix XOR
@@ -117,6 +120,9 @@ func prefixXOR(mask uint64) uint64 {
 type scanState struct {
 	quoted        uint64 // 0 = outside quotes, ^0 = inside quotes
 	skipNextQuote bool   // skip first quote of next chunk (boundary double quote)
+	escaped       bool   // first byte of next chunk is escaped (escape at position 63, escape scans only)
+	prevCR        uint64 // 1 if the previous chunk ended with CR (TerminatorCRLF only)
+	terminator    Terminator
 }
 
 // scanResult holds bitmasks for structural characters from scanning.
@@ -124,15 +130,24 @@ type scanResult struct {
 	quoteMasks     []uint64 // quote positions per chunk
 	separatorMasks []uint64 // separator positions per chunk
 	newlineMasks   []uint64 // newline positions per chunk (CRLF normalized)
-	chunkHasDQ     []bool   // chunks containing escaped double quotes
+	chunkHasDQ     []bool   // chunks containing escaped double quotes or escape sequences
 	chunkHasQuote  []bool   // chunks containing any quote
 	hasQuotes      bool     // input contains quote characters
 	hasCR          bool     // input contains carriage returns
+	hasEscapes     bool     // input contains escape characters (escape mode only)
 	finalQuoted    uint64   // quote state after scanning
 	chunkCount     int      // number of chunks processed
 	lastChunkBits  int      // valid bits in final chunk (< 64)
 	separatorCount int      // total separators (outside quotes)
 	newlineCount   int      // total newlines (outside quotes)
+	separatorLen   int      // bytes per separator; separator bits mark the lead byte
+	terminator     Terminator
+
+	// Checkpoint of a serial scan, for resuming it on appended bytes
+	resumable   bool      // the checkpoint below is set
+	resumeChunk int       // first chunk that may change when bytes are appended
+	resumeState scanState // scanner state before resumeChunk
+	resumeCarry int       // bytes of resumeChunk covered by a separator before it
 }
 
 // chunkMasks holds the four mask types for a single 64-byte chunk.
@@ -182,11 +197,18 @@ func (sr *scanResult) reset() {
 	sr.chunkHasQuote = sr.chunkHasQuote[:0]
 	sr.hasQuotes = false
 	sr.hasCR = false
+	sr.hasEscapes = false
 	sr.finalQuoted = 0
 	sr.chunkCount = 0
 	sr.lastChunkBits = 0
 	sr.separatorCount = 0
 	sr.newlineCount = 0
+	sr.separatorLen = 0
+	sr.terminator = TerminatorAny
+	sr.resumable = false
+	sr.resumeChunk = 0
+	sr.resumeState = scanState{}
+	sr.resumeCarry = 0
 }
 
 // release returns the scanResult to the pool for reuse.
@@ -245,11 +267,11 @@ func ensureBoolSliceCap(s []bool, required int) []bool {
 // =============================================================================
 
 // generateMasksScalar generates masks using scalar operations (fallback for non-AVX-512).
-func generateMasksScalar(data []byte, separator byte) (quote, sep, cr, nl uint64) {
+func generateMasksScalar(data []byte, separator, quoteChar byte) (quote, sep, cr, nl uint64) {
 	for i := 0; i < simdChunkSize; i++ {
 		bit := uint64(1) << i
 		switch data[i] {
-		case '"':
+		case quoteChar:
 			quote |= bit
 		case separator:
 			sep |= bit
@@ -268,10 +290,9 @@ func generateMasksScalar(data []byte, separator byte) (quote, sep, cr, nl uint64
 
 // generateMasksAVX512 generates masks using AVX-512 SIMD instructions.
 // Requires AVX-512BW for ToBits() which uses VPMOVB2M instruction.
-// Uses cached broadcast values for fixed characters (quote, CR, NL) to avoid
-// repeated BroadcastInt8x64 calls.
-func generateMasksAVX512(data []byte, separator byte) (quote, sep, cr, nl uint64) {
-	return generateMasksAVX512WithCmp(data, cachedQuoteCmp, cachedSepCmp[separator], cachedCrCmp, cachedNlCmp)
+// Uses cached broadcast values for all characters to avoid repeated BroadcastInt8x64 calls.
+func generateMasksAVX512(data []byte, separator, quoteChar byte) (quote, sep, cr, nl uint64) {
+	return generateMasksAVX512WithCmp(data, cachedSepCmp[quoteChar], cachedSepCmp[separator], cachedCrCmp, cachedNlCmp)
 }
 
 // generateMasksAVX512WithCmp generates masks reusing pre-broadcasted comparators.
@@ -283,6 +304,42 @@ func generateMasksAVX512WithCmp(data []byte, quoteCmp, sepCmp, crCmp, nlCmp arch
 		chunk.Equal(nlCmp).ToBits()
 }
 
+// =============================================================================
+// Single-Byte Masks
+// =============================================================================
+
+// byteMaskScalar returns the positions of b in the first 64 bytes of data.
+func byteMaskScalar(data []byte, b byte) uint64 {
+	var mask uint64
+	for i := 0; i < len(data) && i < simdChunkSize; i++ {
+		if data[i] == b {
+			mask |= uint64(1) << i
+		}
+	}
+	return mask
+}
+
+// byteMaskAVX512 returns the positions of cmp's byte in the first 64 bytes of data.
+// Zero-filled lanes of a partial chunk match only a NUL byte, which is never searched for.
+func byteMaskAVX512(data []byte, cmp archsimd.Int8x64) uint64 {
+	if len(data) >= simdChunkSize {
+		return archsimd.LoadInt8x64Slice(bytesToInt8Slice(data[:simdChunkSize])).Equal(cmp).ToBits()
+	}
+	return archsimd.LoadInt8x64SlicePart(bytesToInt8Slice(data)).Equal(cmp).ToBits()
+}
+
+// byteMaskAt returns the positions of b in the chunk of buf starting at offset,
+// or 0 if offset is past the end of buf.
+func byteMaskAt(buf []byte, offset int, b byte) uint64 {
+	if offset >= len(buf) {
+		return 0
+	}
+	if useAVX512 {
+		return byteMaskAVX512(buf[offset:], cachedSepCmp[b])
+	}
+	return byteMaskScalar(buf[offset:], b)
+}
+
 // =============================================================================
 // Mask Generation - Unified Dispatch
 // =============================================================================
@@ -290,16 +347,16 @@ func generateMasksAVX512WithCmp(data []byte, quoteCmp, sepCmp, crCmp, nlCmp arch
 // generateMasks generates bitmasks for structural characters in a 64-byte chunk.
 // Returns masks for quote, separator, CR, and newline positions.
 // Dispatches to AVX-512 or scalar implementation based on CPU support.
-func generateMasks(data []byte, separator byte) (quote, sep, cr, nl uint64) {
+func generateMasks(data []byte, separator, quoteChar byte) (quote, sep, cr, nl uint64) {
 	if useAVX512 {
-		return generateMasksAVX512(data, separator)
+		return generateMasksAVX512(data, separator, quoteChar)
 	}
-	return generateMasksScalar(data, separator)
+	return generateMasksScalar(data, separator, quoteChar)
 }
 
 // generateMasksPadded processes chunks smaller than 64 bytes by zero-padding.
 // Returns masks with bits beyond valid data cleared.
-func generateMasksPadded(data []byte, separator byte) (quote, sep, cr, nl uint64, validBits int) {
+func generateMasksPadded(data []byte, separator, quoteChar byte) (quote, sep, cr, nl uint64, validBits int) {
 	validBits = len(data)
 	if validBits == 0 {
 		return 0, 0, 0, 0, 0
@@ -308,7 +365,7 @@ func generateMasksPadded(data []byte, separator byte) (quote, sep, cr, nl uint64
 	var padded [simdChunkSize]byte
 	copy(padded[:], data)
 
-	quote, sep, cr, nl = generateMasks(padded[:], separator)
+	quote, sep, cr, nl = generateMasks(padded[:], separator, quoteChar)
 
 	if validBits < simdChunkSize {
 		mask := (uint64(1) << validBits) - 1
@@ -468,14 +525,28 @@ func invalidateNewlinesInQuotes(quoteMask, newlineMask uint64, state *scanState)
 // =============================================================================
 
 // scanBuffer dispatches to the AVX-512 or scalar implementation.
-func scanBuffer(buf []byte, separatorChar byte) *scanResult {
+func scanBuffer(buf []byte, separatorChar, quoteChar byte) *scanResult {
 	if len(buf) == 0 {
 		return &scanResult{}
 	}
 	if useAVX512 {
-		return scanBufferAVX512(buf, separatorChar)
+		return scanBufferAVX512(buf, separatorChar, quoteChar)
+	}
+	return scanBufferScalar(buf, separatorChar, quoteChar)
+}
+
+// newMaskGenerator returns the AVX-512 or scalar mask generator.
+// The nl masks match newlineChar, which is '\n' unless a custom Terminator
+// is configured.
+func newMaskGenerator(separatorChar, quoteChar, newlineChar byte) maskGenerator {
+	if useAVX512 {
+		gen := newAVX512MaskGenerator(separatorChar, quoteChar)
+		if newlineChar != '\n' {
+			gen.nlCmp = cachedSepCmp[newlineChar]
+		}
+		return gen
 	}
-	return scanBufferScalar(buf, separatorChar)
+	return &scalarMaskGenerator{separator: separatorChar, quote: quoteChar, newline: newlineChar}
 }
 
 // =============================================================================
@@ -485,22 +556,35 @@ func scanBuffer(buf []byte, separatorChar byte) *scanResult {
 // scalarMaskGenerator generates masks for a chunk using scalar operations.
 type scalarMaskGenerator struct {
 	separator byte
+	quote     byte
+	newline   byte // matched in place of LF when not 0 or '\n'
 }
 
 func (g *scalarMaskGenerator) generateFull(data []byte) chunkMasks {
-	quote, sep, cr, nl := generateMasksScalar(data, g.separator)
-	return chunkMasks{quote: quote, sep: sep, cr: cr, nl: nl}
+	quote, sep, cr, nl := generateMasksScalar(data, g.separator, g.quote)
+	m := chunkMasks{quote: quote, sep: sep, cr: cr, nl: nl}
+	g.replaceNewline(data, &m)
+	return m
 }
 
 func (g *scalarMaskGenerator) generatePadded(data []byte) (chunkMasks, int) {
-	quote, sep, cr, nl, validBits := generateMasksPadded(data, g.separator)
-	return chunkMasks{quote: quote, sep: sep, cr: cr, nl: nl}, validBits
+	quote, sep, cr, nl, validBits := generateMasksPadded(data, g.separator, g.quote)
+	m := chunkMasks{quote: quote, sep: sep, cr: cr, nl: nl}
+	g.replaceNewline(data, &m)
+	return m, validBits
+}
+
+// replaceNewline replaces the LF mask with the mask of a custom newline.
+func (g *scalarMaskGenerator) replaceNewline(data []byte, m *chunkMasks) {
+	if g.newline != 0 && g.newline != '\n' {
+		m.nl = byteMaskScalar(data, g.newline)
+	}
 }
 
 // scanBufferScalar processes the buffer using scalar mask generation.
-func scanBufferScalar(buf []byte, separatorChar byte) *scanResult {
-	gen := &scalarMaskGenerator{separator: separatorChar}
-	return scanBufferWithGenerator(buf, gen)
+func scanBufferScalar(buf []byte, separatorChar, quoteChar byte) *scanResult {
+	gen := &scalarMaskGenerator{separator: separatorChar, quote: quoteChar}
+	return scanBufferWithGenerator(buf, gen, TerminatorAny)
 }
 
 // =============================================================================
@@ -515,9 +599,9 @@ type avx512MaskGenerator struct {
 	nlCmp    archsimd.Int8x64
 }
 
-func newAVX512MaskGenerator(separator byte) *avx512MaskGenerator {
+func newAVX512MaskGenerator(separator, quote byte) *avx512MaskGenerator {
 	return &avx512MaskGenerator{
-		quoteCmp: cachedQuoteCmp,
+		quoteCmp: cachedSepCmp[quote],
 		sepCmp:   cachedSepCmp[separator],
 		crCmp:    cachedCrCmp,
 		nlCmp:    cachedNlCmp,
@@ -537,12 +621,12 @@ func (g *avx512MaskGenerator) generatePadded(data []byte) (chunkMasks, int) {
 // scanBufferAVX512 processes the buffer using AVX-512 mask generation.
 //
 //go:noinline
-func scanBufferAVX512(buf []byte, separatorChar byte) *scanResult {
+func scanBufferAVX512(buf []byte, separatorChar, quoteChar byte) *scanResult {
 	if len(buf) == 0 {
 		return &scanResult{}
 	}
-	gen := newAVX512MaskGenerator(separatorChar)
-	return scanBufferWithGenerator(buf, gen)
+	gen := newAVX512MaskGenerator(separatorChar, quoteChar)
+	return scanBufferWithGenerator(buf, gen, TerminatorAny)
 }
 
 // =============================================================================
@@ -557,38 +641,42 @@ type maskGenerator interface {
 
 // scanBufferWithGenerator processes the buffer using the provided mask generator.
 // This unified implementation eliminates duplication between SIMD and scalar paths.
-func scanBufferWithGenerator(buf []byte, gen maskGenerator) *scanResult {
-	chunkCount := (len(buf) + simdChunkSize - 1) / simdChunkSize
-
-	result := acquireScanResult(chunkCount)
-	state := scanState{}
-
-	sc := bufferScanContext{
-		buf:        buf,
-		gen:        gen,
-		result:     result,
-		chunkCount: chunkCount,
-	}
-
-	curMasks, curValidBits := sc.generateFirstChunkMasks()
-	nextMasks := sc.generateSecondChunkMasks()
+func scanBufferWithGenerator(buf []byte, gen maskGenerator, term Terminator) *scanResult {
+	result := acquireScanResult((len(buf) + simdChunkSize - 1) / simdChunkSize)
+	result.terminator = term
+	scanChunks(buf, gen, 0, scanState{terminator: term}, result)
+	return result
+}
 
-	for chunkIdx := 0; chunkIdx < chunkCount; chunkIdx++ {
+// scanChunks scans the chunks of buf from chunk first on, starting in state.
+// The state before the checkpoint chunk is recorded so the scan can be resumed
+// once bytes are appended to buf.
+func scanChunks(buf []byte, gen maskGenerator, first int, state scanState, result *scanResult) {
+	resumeChunk := checkpointChunk(len(buf))
+	curMasks, curValidBits := chunkMasksAt(buf, gen, first)
+	for chunkIdx := first; chunkIdx < result.chunkCount; chunkIdx++ {
+		if chunkIdx == resumeChunk {
+			result.setCheckpoint(chunkIdx, state)
+		}
+		nextMasks, nextValidBits := chunkMasksAt(buf, gen, chunkIdx+1)
 		processChunk(chunkIdx, curMasks, nextMasks, curValidBits, &state, result)
-
-		curMasks = nextMasks
-		nextMasks, curValidBits = sc.generateNextLookahead(chunkIdx)
+		curMasks, curValidBits = nextMasks, nextValidBits
 	}
 
 	result.finalQuoted = state.quoted
-	return result
+	result.lastChunkBits = len(buf) % simdChunkSize
 }
 
-type bufferScanContext struct {
-	buf        []byte
-	gen        maskGenerator
-	result     *scanResult
-	chunkCount int
+// chunkMasksAt generates masks for the chunk at chunkIdx, or empty masks past the end of buf.
+func chunkMasksAt(buf []byte, gen maskGenerator, chunkIdx int) (chunkMasks, int) {
+	offset := chunkIdx * simdChunkSize
+	if offset >= len(buf) {
+		return chunkMasks{}, 0
+	}
+	if len(buf)-offset >= simdChunkSize {
+		return gen.generateFull(buf[offset : offset+simdChunkSize]), simdChunkSize
+	}
+	return gen.generatePadded(buf[offset:])
 }
 
 // acquireScanResult gets a pooled scanResult and initializes it for the given chunk count.
@@ -614,68 +702,6 @@ func acquireScanResult(chunkCount int) *scanResult {
 	return result
 }
 
-// generateFirstChunkMasks generates masks for the first chunk of the buffer.
-// Handles both full chunks and partial (padded) chunks.
-func (sc *bufferScanContext) generateFirstChunkMasks() (chunkMasks, int) {
-	if len(sc.buf) >= simdChunkSize {
-		return sc.gen.generateFull(sc.buf[0:simdChunkSize]), simdChunkSize
-	}
-
-	masks, validBits := sc.gen.generatePadded(sc.buf)
-	sc.result.lastChunkBits = validBits
-	return masks, validBits
-}
-
-// generateSecondChunkMasks generates lookahead masks for the second chunk if it exists.
-// Returns empty masks if there is no second chunk.
-func (sc *bufferScanContext) generateSecondChunkMasks() chunkMasks {
-	if sc.chunkCount <= 1 || len(sc.buf) <= simdChunkSize {
-		return chunkMasks{}
-	}
-
-	if len(sc.buf) >= 2*simdChunkSize {
-		return sc.gen.generateFull(sc.buf[simdChunkSize : 2*simdChunkSize])
-	}
-
-	masks, validBits := sc.gen.generatePadded(sc.buf[simdChunkSize:])
-	if sc.chunkCount == 2 {
-		sc.result.lastChunkBits = validBits
-	}
-	return masks
-}
-
-// generateNextLookahead generates masks for the chunk two positions ahead (lookahead).
-// This enables processing current chunk while knowing what comes next.
-func (sc *bufferScanContext) generateNextLookahead(chunkIdx int) (chunkMasks, int) {
-	lookaheadIdx := chunkIdx + 2
-	if lookaheadIdx >= sc.chunkCount {
-		return sc.handleFinalChunkValidBits(chunkIdx)
-	}
-
-	offset := lookaheadIdx * simdChunkSize
-	remaining := len(sc.buf) - offset
-
-	if remaining >= simdChunkSize {
-		return sc.gen.generateFull(sc.buf[offset : offset+simdChunkSize]), simdChunkSize
-	}
-
-	masks, validBits := sc.gen.generatePadded(sc.buf[offset:])
-	sc.result.lastChunkBits = validBits
-	return masks, validBits
-}
-
-// handleFinalChunkValidBits computes valid bits when no more lookahead chunks exist.
-func (sc *bufferScanContext) handleFinalChunkValidBits(chunkIdx int) (chunkMasks, int) {
-	validBits := simdChunkSize
-
-	if chunkIdx+1 == sc.chunkCount-1 && len(sc.buf)%simdChunkSize != 0 {
-		validBits = len(sc.buf) % simdChunkSize
-		sc.result.lastChunkBits = validBits
-	}
-
-	return chunkMasks{}, validBits
-}
-
 // initScanResultSlices pre-sizes all slices for index-based assignment.
 func initScanResultSlices(result *scanResult, chunkCount int) {
 	result.quoteMasks = ensureUint64SliceCap(result.quoteMasks, chunkCount)
@@ -692,7 +718,7 @@ func initScanResultSlices(result *scanResult, chunkCount int) {
 // processChunk handles the main logic for a single chunk.
 func processChunk(chunkIdx int, curMasks, nextMasks chunkMasks, validBits int, state *scanState, result *scanResult) {
 	quoteMask := applyBoundaryQuoteSkip(curMasks.quote, state)
-	newlineMask := normalizeCRLF(curMasks.cr, curMasks.nl, nextMasks.nl, validBits)
+	newlineMask := recordTerminators(curMasks, nextMasks, validBits, state)
 
 	if curMasks.cr != 0 {
 		result.hasCR = true
@@ -773,3 +799,266 @@ func storeChunkResults(chunkIdx int, quoteMask, sepMask, newlineMask uint64, has
 	result.separatorCount += bits.OnesCount64(sepMask)
 	result.newlineCount += bits.OnesCount64(newlineMask)
 }
+
+// =============================================================================
+// Multi-Byte Separators
+// =============================================================================
+//
+// A separator longer than one byte is either a Comma outside ASCII (a UTF-8
+// sequence of 2-4 bytes) or a multi-character Delimiter such as "||" or
+// "~|~". The chunk scan matches only the lead byte, and confirmSeparators
+// filters those candidates with shifted masks of the remaining bytes:
+//
+//	match[i] = lead[i] & eq(sep[1])[i+1] & ... & eq(sep[k-1])[i+k-1]
+//
+// The mask for sep[j] is shifted down by j and the low j bits of the next
+// chunk's mask fill the top, so a sequence straddling the 64-byte chunk
+// boundary is confirmed like any other. Separators never contain the quote,
+// CR or LF, so those masks are unaffected.
+//
+// Separator bits mark the lead byte; the parser skips separatorLen bytes.
+// Self-overlapping separators such as "||" match leftmost-first, so "|||" is
+// one separator followed by a literal '|'.
+//
+// =============================================================================
+
+// scanBufferSeparator scans buf for the encoded separator sep.
+func scanBufferSeparator(buf, sep []byte, quoteChar byte) *scanResult {
+	sr := scanBuffer(buf, sep[0], quoteChar)
+	confirmSeparators(buf, sep, sr)
+	return sr
+}
+
+// scanBufferDialect scans buf serially for the encoded separator sep, the
+// record terminator term and, if escapeChar is not 0, escaped bytes.
+func scanBufferDialect(buf, sep []byte, quoteChar, escapeChar byte, term Terminator) *scanResult {
+	if len(buf) == 0 {
+		return &scanResult{}
+	}
+	gen := newMaskGenerator(sep[0], quoteChar, term.newlineByte())
+	var sr *scanResult
+	if escapeChar != 0 {
+		sr = scanBufferEscaped(buf, gen, escapeChar, term)
+	} else {
+		sr = scanBufferWithGenerator(buf, gen, term)
+	}
+	confirmSeparators(buf, sep, sr)
+	return sr
+}
+
+// resumeScanDialect extends sr, a scanBufferDialect scan rebased onto the
+// start of buf, to all of buf. Only the chunks from the checkpoint on are scanned.
+func resumeScanDialect(buf, sep []byte, quoteChar, escapeChar byte, sr *scanResult) {
+	first, carry := sr.resumeChunk, sr.resumeCarry
+	sr.grow((len(buf) + simdChunkSize - 1) / simdChunkSize)
+	gen := newMaskGenerator(sep[0], quoteChar, sr.terminator.newlineByte())
+	if escapeChar != 0 {
+		scanChunksEscaped(buf, gen, escapeChar, first, sr.resumeState, sr)
+	} else {
+		scanChunks(buf, gen, first, sr.resumeState, sr)
+	}
+	confirmSeparatorsFrom(buf, sep, sr, first, carry)
+}
+
+// confirmSeparators clears separator bits not followed by the rest of sep
+// and records the separator length for the parser.
+func confirmSeparators(buf, sep []byte, sr *scanResult) {
+	confirmSeparatorsFrom(buf, sep, sr, 0, 0)
+}
+
+// confirmSeparatorsFrom is confirmSeparators for the chunks from first on,
+// where carry bytes of chunk first are covered by a separator before it.
+func confirmSeparatorsFrom(buf, sep []byte, sr *scanResult, first, carry int) {
+	sr.separatorLen = len(sep)
+	if len(sep) == 1 {
+		return
+	}
+
+	overlapping := hasBorder(sep)
+	// carry: bytes of the next chunk covered by a separator in this chunk
+	for chunkIdx := first; chunkIdx < sr.chunkCount; chunkIdx++ {
+		if chunkIdx == sr.resumeChunk {
+			sr.resumeCarry = carry
+		}
+		mask := sr.separatorMasks[chunkIdx]
+		if mask == 0 {
+			carry = 0
+			continue
+		}
+
+		offset := chunkIdx * simdChunkSize
+		for j := 1; j < len(sep) && mask != 0; j++ {
+			mask &= shiftedByteMask(buf, offset, sep[j], j)
+		}
+		if overlapping {
+			mask &^= uint64(1)<<carry - 1
+			mask, carry = dropOverlappingSeparators(mask, len(sep))
+		}
+
+		sr.separatorCount -= bits.OnesCount64(sr.separatorMasks[chunkIdx]) - bits.OnesCount64(mask)
+		sr.separatorMasks[chunkIdx] = mask
+	}
+}
+
+// shiftedByteMask returns the mask whose bit i is set when buf[offset+i+shift] == b.
+// Bits past the chunk are taken from the next chunk's mask.
+func shiftedByteMask(buf []byte, offset int, b byte, shift int) uint64 {
+	return byteMaskAt(buf, offset, b)>>shift | byteMaskAt(buf, offset+simdChunkSize, b)<<(simdChunkSize-shift)
+}
+
+// dropOverlappingSeparators keeps the leftmost of overlapping separator matches.
+// Returns the filtered mask and the number of bytes of the next chunk covered
+// by the last separator kept.
+func dropOverlappingSeparators(mask uint64, sepLen int) (uint64, int) {
+	carry := 0
+	for work := mask; work != 0; {
+		pos := bits.TrailingZeros64(work)
+		end := pos + sepLen // first byte after the separator
+		if end >= simdChunkSize {
+			carry = end - simdChunkSize
+			mask &^= ^uint64(0) << (pos + 1)
+			break
+		}
+		mask &^= (uint64(1)<<(sepLen-1) - 1) << (pos + 1)
+		work = mask &^ (uint64(1)<<(pos+1) - 1)
+	}
+	return mask, carry
+}
+
+// hasBorder reports whether a proper prefix of sep is also a suffix of it,
+// meaning two occurrences of sep can overlap ("||", "~|~"). UTF-8 encoded
+// runes never do.
+func hasBorder(sep []byte) bool {
+	for i := 1; i < len(sep); i++ {
+		if bytes.HasPrefix(sep, sep[i:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// separatorWidth returns the byte length of the scanned separator (at least 1).
+func (sr *scanResult) separatorWidth() uint64 {
+	if sr.separatorLen > 1 {
+		return uint64(sr.separatorLen) //nolint:gosec // G115: bounded by maxDelimiterLen
+	}
+	return 1
+}
+
+// =============================================================================
+// Window Support
+// =============================================================================
+
+// lastRecordEnd returns the offset just past the last record terminator in buf,
+// or 0 if buf contains no complete record.
+// With TerminatorAny, a CR in the final byte is not treated as a terminator
+// because the LF of a CRLF pair may still follow in unread input.
+func (sr *scanResult) lastRecordEnd(buf []byte) int {
+	for chunkIdx := sr.chunkCount - 1; chunkIdx >= 0; chunkIdx-- {
+		mask := sr.newlineMasks[chunkIdx]
+		for mask != 0 {
+			pos := 63 - bits.LeadingZeros64(mask)
+			absPos := chunkIdx*simdChunkSize + pos
+			if sr.terminator == TerminatorAny && absPos == len(buf)-1 && buf[absPos] == '\r' {
+				mask &^= uint64(1) << pos
+				continue
+			}
+			return absPos + 1
+		}
+	}
+	return 0
+}
+
+// truncate limits the scanResult to the first n bytes of the scanned buffer.
+// Structural bits at or beyond n are cleared and the counts are recomputed.
+func (sr *scanResult) truncate(n int) {
+	chunkCount := (n + simdChunkSize - 1) / simdChunkSize
+	sr.chunkCount = chunkCount
+	sr.quoteMasks = sr.quoteMasks[:chunkCount]
+	sr.separatorMasks = sr.separatorMasks[:chunkCount]
+	sr.newlineMasks = sr.newlineMasks[:chunkCount]
+	sr.chunkHasDQ = sr.chunkHasDQ[:chunkCount]
+	sr.chunkHasQuote = sr.chunkHasQuote[:chunkCount]
+
+	if validBits := n % simdChunkSize; validBits != 0 {
+		mask := (uint64(1) << validBits) - 1
+		last := chunkCount - 1
+		sr.quoteMasks[last] &= mask
+		sr.separatorMasks[last] &= mask
+		sr.newlineMasks[last] &= mask
+		sr.lastChunkBits = validBits
+	} else {
+		sr.lastChunkBits = 0
+	}
+
+	sr.separatorCount = 0
+	sr.newlineCount = 0
+	for i := 0; i < chunkCount; i++ {
+		sr.separatorCount += bits.OnesCount64(sr.separatorMasks[i])
+		sr.newlineCount += bits.OnesCount64(sr.newlineMasks[i])
+	}
+}
+
+// =============================================================================
+// Resuming Scans
+// =============================================================================
+//
+// A window ends inside its last chunks, and the pending tail is carried into
+// the next window. A serial scan records a checkpoint, the scanner state before
+// the last full chunk: a chunk looks ahead into the next one only, so the masks
+// of earlier chunks stay valid when bytes are appended to the buffer.
+//
+// The next window starts with the carried bytes from a chunk boundary, so
+// their masks stay aligned. rebase moves the masks of the chunks before the
+// checkpoint to the front, and resumeScanDialect scans on from the checkpoint.
+//
+// =============================================================================
+
+// checkpointChunk returns the chunk a scan of bufLen bytes records its checkpoint at.
+func checkpointChunk(bufLen int) int {
+	return max(bufLen/simdChunkSize-1, 0)
+}
+
+// setCheckpoint records state as the scanner state before chunkIdx.
+func (sr *scanResult) setCheckpoint(chunkIdx int, state scanState) {
+	sr.resumable = true
+	sr.resumeChunk = chunkIdx
+	sr.resumeState = state
+}
+
+// rebase keeps the chunks of the scan of buf from first up to the checkpoint,
+// for a buffer that starts with them. The flags and counts are recomputed for
+// the kept chunks; their bytes are searched for a CR only if the scan saw one.
+func (sr *scanResult) rebase(buf []byte, first int) {
+	last := sr.resumeChunk
+	n := copy(sr.quoteMasks, sr.quoteMasks[first:last])
+	copy(sr.separatorMasks, sr.separatorMasks[first:last])
+	copy(sr.newlineMasks, sr.newlineMasks[first:last])
+	copy(sr.chunkHasDQ, sr.chunkHasDQ[first:last])
+	copy(sr.chunkHasQuote, sr.chunkHasQuote[first:last])
+	sr.chunkCount = n
+	sr.resumeChunk = n
+
+	sr.hasQuotes = slices.Contains(sr.chunkHasQuote[:n], true)
+	sr.hasEscapes = sr.hasEscapes && slices.Contains(sr.chunkHasDQ[:n], true)
+	sr.hasCR = sr.hasCR && bytes.IndexByte(buf[first*simdChunkSize:last*simdChunkSize], '\r') >= 0
+	sr.separatorCount = 0
+	sr.newlineCount = 0
+	for i := range n {
+		sr.separatorCount += bits.OnesCount64(sr.separatorMasks[i])
+		sr.newlineCount += bits.OnesCount64(sr.newlineMasks[i])
+	}
+}
+
+// grow extends the scan to chunkCount chunks, keeping the masks of the scanned ones.
+func (sr *scanResult) grow(chunkCount int) {
+	first := sr.chunkCount
+	sr.quoteMasks = slices.Grow(sr.quoteMasks[:first], chunkCount-first)[:chunkCount]
+	sr.separatorMasks = slices.Grow(sr.separatorMasks[:first], chunkCount-first)[:chunkCount]
+	sr.newlineMasks = slices.Grow(sr.newlineMasks[:first], chunkCount-first)[:chunkCount]
+	sr.chunkHasDQ = slices.Grow(sr.chunkHasDQ[:first], chunkCount-first)[:chunkCount]
+	sr.chunkHasQuote = slices.Grow(sr.chunkHasQuote[:first], chunkCount-first)[:chunkCount]
+	clear(sr.chunkHasDQ[first:])
+	clear(sr.chunkHasQuote[first:])
+	sr.chunkCount = chunkCount
+}
@@ -246,7 +246,7 @@ func TestGenerateMasks(t *testing.T) {
 				t.Fatalf("test input must be exactly 64 bytes, got %d", len(tt.input))
 			}
 
-			quote, sep, cr, nl := generateMasks(tt.input, tt.separator)
+			quote, sep, cr, nl := generateMasks(tt.input, tt.separator, '"')
 
 			gotQuotePos := maskPositions(quote)
 			gotSepPos := maskPositions(sep)
@@ -386,7 +386,7 @@ func TestGenerateMasksPadded(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			quote, sep, cr, nl, validBits := generateMasksPadded(tt.input, tt.separator)
+			quote, sep, cr, nl, validBits := generateMasksPadded(tt.input, tt.separator, '"')
 
 			if validBits != tt.wantValidBits {
 				t.Errorf("validBits = %d, want %d", validBits, tt.wantValidBits)
@@ -453,7 +453,7 @@ func TestGenerateMasksPadded_ValidBitsOnly(t *testing.T) {
 				input[length-1] = '"'
 			}
 
-			_, sep, _, _, validBits := generateMasksPadded(input, ',')
+			_, sep, _, _, validBits := generateMasksPadded(input, ',', '"')
 
 			if validBits != length {
 				t.Errorf("validBits = %d, want %d", validBits, length)
@@ -554,7 +554,7 @@ func TestCRLFNormalization(t *testing.T) {
 			}
 
 			// Generate raw masks
-			_, _, crMask, nlMask := generateMasks(tt.input, ',')
+			_, _, crMask, nlMask := generateMasks(tt.input, ',', '"')
 
 			// Apply CRLF normalization logic (as per design doc section 3.5)
 			// CRLF pairs: CR followed by LF - CR is removed from newline mask
@@ -626,8 +626,8 @@ func TestCRLFBoundary(t *testing.T) {
 			}
 
 			// Get masks for both chunks
-			_, _, cr1, nl1 := generateMasks(tt.chunk1, ',')
-			_, _, _, nl2 := generateMasks(tt.chunk2, ',')
+			_, _, cr1, nl1 := generateMasks(tt.chunk1, ',', '"')
+			_, _, _, nl2 := generateMasks(tt.chunk2, ',', '"')
 
 			// Check boundary condition: CR at position 63 of chunk1
 			crAtBoundary := cr1&(1<<63) != 0
@@ -725,8 +725,8 @@ func TestChunkBoundaryQuotes(t *testing.T) {
 			}
 
 			// Get quote masks
-			quote1, _, _, _ := generateMasks(tt.chunk1, ',')
-			quote2, _, _, _ := generateMasks(tt.chunk2, ',')
+			quote1, _, _, _ := generateMasks(tt.chunk1, ',', '"')
+			quote2, _, _, _ := generateMasks(tt.chunk2, ',', '"')
 
 			// Check boundary condition
 			quoteAtChunk1End := quote1&(1<<63) != 0
@@ -921,7 +921,7 @@ func TestScanBuffer(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := scanBuffer(tt.input, tt.separator)
+			result := scanBuffer(tt.input, tt.separator, '"')
 
 			if result.chunkCount != tt.wantChunkCount {
 				t.Errorf("%s: chunkCount = %d, want %d",
@@ -992,7 +992,7 @@ func TestScanBuffer_MaskContent(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := scanBuffer(tt.input, tt.separator)
+			result := scanBuffer(tt.input, tt.separator, '"')
 
 			if tt.chunkIdx >= len(result.separatorMasks) {
 				t.Fatalf("chunkIdx %d out of range (have %d chunks)",
@@ -1045,7 +1045,7 @@ func TestScanBuffer_MultiChunk(t *testing.T) {
 		input = append(input, make([]byte, 129-len(input))...)
 	}
 
-	result := scanBuffer(input, ',')
+	result := scanBuffer(input, ',', '"')
 
 	expectedChunkCount := (len(input) + 63) / 64
 	if result.chunkCount != expectedChunkCount {
@@ -1071,7 +1071,7 @@ func TestScanBuffer_MultiChunk(t *testing.T) {
 
 // TestScanBuffer_Empty tests empty input handling
 func TestScanBuffer_Empty(t *testing.T) {
-	result := scanBuffer([]byte{}, ',')
+	result := scanBuffer([]byte{}, ',', '"')
 
 	if result.chunkCount != 0 {
 		t.Errorf("empty input should have 0 chunks, got %d", result.chunkCount)
@@ -1109,7 +1109,7 @@ func TestScanBuffer_LastChunkBits(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(fmt.Sprintf("len_%d", tt.inputLen), func(t *testing.T) {
 			input := make([]byte, tt.inputLen)
-			result := scanBuffer(input, ',')
+			result := scanBuffer(input, ',', '"')
 
 			if result.lastChunkBits != tt.wantLastChunkBits {
 				t.Errorf("lastChunkBits = %d, want %d", result.lastChunkBits, tt.wantLastChunkBits)
@@ -1118,6 +1118,79 @@ func TestScanBuffer_LastChunkBits(t *testing.T) {
 	}
 }
 
+// TestScanBuffer_PartialChunkCRLF tests a CRLF split between the last full
+// chunk and a partial final chunk.
+func TestScanBuffer_PartialChunkCRLF(t *testing.T) {
+	input := []byte(strings.Repeat("a", 63) + "\r\nb")
+	result := scanBuffer(input, ',', '"')
+
+	var got []int
+	for i := range result.chunkCount {
+		for _, pos := range maskPositions(result.newlineMasks[i]) {
+			got = append(got, i*64+pos)
+		}
+	}
+	if !equalPositions(got, []int{64}) {
+		t.Errorf("newline positions = %v, want [64]", got)
+	}
+}
+
+// TestScanBuffer_Resume tests that resuming a scan on appended bytes gives
+// the masks of a scan of the whole buffer.
+func TestScanBuffer_Resume(t *testing.T) {
+	line := "id,\"quoted, with\r\nbreak\",\"say \"\"hi\"\"\",x\\,y\r\n"
+	tests := []struct {
+		name   string
+		sep    string
+		escape byte
+		term   Terminator
+	}{
+		{"comma", ",", 0, TerminatorAny},
+		{"multi_byte_separator", "||", 0, TerminatorAny},
+		{"escape", ",", '\\', TerminatorAny},
+		{"crlf_terminator", ",", 0, TerminatorCRLF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := []byte(strings.Repeat(strings.ReplaceAll(line, ",", tt.sep), 12))
+			want := scanBufferDialect(input, []byte(tt.sep), '"', tt.escape, tt.term)
+			for n := 1; n < len(input); n += 7 {
+				sr := scanBufferDialect(input[:n], []byte(tt.sep), '"', tt.escape, tt.term)
+				if !sr.resumable {
+					t.Fatalf("scan of %d bytes is not resumable", n)
+				}
+				sr.rebase(input[:n], 0)
+				resumeScanDialect(input, []byte(tt.sep), '"', tt.escape, sr)
+				if err := compareScans(sr, want); err != "" {
+					t.Fatalf("resumed after %d bytes: %s", n, err)
+				}
+			}
+		})
+	}
+}
+
+// compareScans describes the first difference between two scans, if any.
+func compareScans(got, want *scanResult) string {
+	if got.chunkCount != want.chunkCount || got.lastChunkBits != want.lastChunkBits {
+		return fmt.Sprintf("chunks = %d/%d bits, want %d/%d", got.chunkCount, got.lastChunkBits, want.chunkCount, want.lastChunkBits)
+	}
+	for i := range want.chunkCount {
+		if got.quoteMasks[i] != want.quoteMasks[i] || got.separatorMasks[i] != want.separatorMasks[i] ||
+			got.newlineMasks[i] != want.newlineMasks[i] || got.chunkHasQuote[i] != want.chunkHasQuote[i] ||
+			got.chunkHasDQ[i] != want.chunkHasDQ[i] {
+			return fmt.Sprintf("chunk %d differs", i)
+		}
+	}
+	if got.separatorCount != want.separatorCount || got.newlineCount != want.newlineCount {
+		return fmt.Sprintf("counts = %d/%d, want %d/%d", got.separatorCount, got.newlineCount, want.separatorCount, want.newlineCount)
+	}
+	if got.hasQuotes != want.hasQuotes || got.hasCR != want.hasCR || got.hasEscapes != want.hasEscapes || got.finalQuoted != want.finalQuoted {
+		return "flags differ"
+	}
+	return ""
+}
+
 // ============================================================================
 // Helper functions
 // ============================================================================
@@ -1165,6 +1238,67 @@ func popcount(x uint64) int {
 // TestPrefixXOR - Test prefix XOR computation (PCLMULQDQ optimization)
 // ============================================================================
 
+// TestEscapedPositions tests escape pairing within a chunk and across chunk boundaries.
+func TestEscapedPositions(t *testing.T) {
+	tests := []struct {
+		name        string
+		esc         uint64
+		carryIn     bool
+		want        uint64
+		wantCarried bool
+	}{
+		{name: "none", esc: 0, want: 0},
+		{name: "single escape", esc: 0b1, want: 0b10},
+		{name: "escaped escape", esc: 0b11, want: 0b10},
+		{name: "three escapes", esc: 0b111, want: 0b1010},
+		{name: "separate escapes", esc: 0b1001, want: 0b10010},
+		{name: "escape at position 63", esc: uint64(1) << 63, want: 0, wantCarried: true},
+		{name: "escaped escape at 62-63", esc: uint64(3) << 62, want: uint64(1) << 63},
+		{name: "carry into plain byte", carryIn: true, want: 0b1},
+		{name: "carry into escape", esc: 0b11, carryIn: true, want: 0b101},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := scanState{escaped: tt.carryIn}
+			got := escapedPositions(tt.esc, &state)
+			if got != tt.want {
+				t.Errorf("escapedPositions(0x%x) = 0x%x, want 0x%x", tt.esc, got, tt.want)
+			}
+			if state.escaped != tt.wantCarried {
+				t.Errorf("escaped carry = %v, want %v", state.escaped, tt.wantCarried)
+			}
+		})
+	}
+}
+
+// TestDropOverlappingSeparators tests leftmost-first selection of overlapping separator matches.
+func TestDropOverlappingSeparators(t *testing.T) {
+	tests := []struct {
+		name      string
+		mask      uint64
+		sepLen    int
+		want      uint64
+		wantCarry int
+	}{
+		{name: "disjoint", mask: 0b1001, sepLen: 2, want: 0b1001},
+		{name: "run of three", mask: 0b111, sepLen: 2, want: 0b101},
+		{name: "three-byte overlap", mask: 0b10100, sepLen: 3, want: 0b100},
+		{name: "crosses boundary", mask: uint64(1)<<63 | uint64(1)<<62, sepLen: 2, want: uint64(1) << 62},
+		{name: "carry into next chunk", mask: uint64(1) << 63, sepLen: 3, want: uint64(1) << 63, wantCarry: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, carry := dropOverlappingSeparators(tt.mask, tt.sepLen)
+			if got != tt.want || carry != tt.wantCarry {
+				t.Errorf("dropOverlappingSeparators(0x%x, %d) = (0x%x, %d), want (0x%x, %d)",
+					tt.mask, tt.sepLen, got, carry, tt.want, tt.wantCarry)
+			}
+		})
+	}
+}
+
 func TestPrefixXOR(t *testing.T) {
 	// prefixXOR computes the cumulative XOR: result[i] = XOR of bits 0..i
 	// This is used for quote region detection: bit i is set if there's an
@@ -0,0 +1,358 @@
+//go:build goexperiment.simd && amd64
+
+//nolint:gosec // G115: Integer conversions are safe - window size bounded by buffer capacity
+package simdcsv
+
+import "io"
+
+// ============================================================================
+// Streaming Window
+// ============================================================================
+//
+// The Reader processes its input as a sequence of windows rather than reading
+// the whole source up front:
+//
+//	source --fill--> [ complete records | partial tail ]
+//	                   ^ scanned + parsed   ^ carried into the next window
+//
+// Each window is cut right after the last record terminator that lies outside
+// quotes. The tail is carried forward together with the scan of its bytes:
+// the tail is moved to the front of the next window from the chunk boundary
+// before it, and the serial scan resumes from its checkpoint, so only the
+// newly read bytes are scanned. The few bytes before the tail that come along
+// belong to the previous window, and the records of the next one start after
+// them (head). Windows scanned in parallel carry the raw tail, which is
+// rescanned as the head of the next window.
+//
+// Windows are ReaderOptions.BufferSize bytes, and the buffer of a window is
+// reused for the next one. A record longer than the window grows the window
+// on demand, up to ReaderOptions.MaxBufferSize.
+//
+// =============================================================================
+
+// defaultBufferSize is the default read window size.
+// It matches scanResultPoolCapacity so a full window fits a pooled scanResult.
+const defaultBufferSize = scanResultPoolCapacity * simdChunkSize
+
+// maxConsecutiveEmptyReads bounds source reads returning no data and no error.
+const maxConsecutiveEmptyReads = 100
+
+// advanceWindow replaces the current window with the next run of complete records.
+// Returns io.EOF when the source is exhausted and no bytes remain.
+func (r *Reader) advanceWindow() error {
+	if r.state.inputErr != nil {
+		return r.state.inputErr
+	}
+	if err := r.ctxErr(); err != nil {
+		return err
+	}
+
+	r.consumeWindow()
+	window, pending := r.state.window, r.state.pending
+	tail, head, sr := r.carryScan(window, len(window)-len(pending), r.state.scanResult)
+	r.state.pending = nil
+	r.state.scanResult = nil
+
+	for {
+		buf, err := r.fillWindow(tail)
+		if err != nil {
+			sr.release()
+			if ctxErr := r.ctxErr(); ctxErr != nil && err == ctxErr {
+				// Not sticky: keep the bytes read so far for the next call
+				r.state.window = buf
+				r.state.pending = buf[head:]
+				return err
+			}
+			r.state.inputErr = err
+			return err
+		}
+		buf = r.skipUTF8BOM(buf)
+		r.state.window = buf
+		if len(buf) == 0 {
+			return io.EOF
+		}
+
+		sr = r.resumeScan(buf, sr)
+		end := len(buf)
+		if !r.state.sourceEOF {
+			end = sr.lastRecordEnd(buf)
+			if end <= head {
+				// No complete record yet: grow the window and scan the new bytes
+				if r.atMaxBufferSize(len(buf) - head) {
+					sr.release()
+					r.state.inputErr = ErrRecordTooLarge
+					return ErrRecordTooLarge
+				}
+				tail, head, sr = r.carryScan(buf, head, sr)
+				continue
+			}
+		}
+
+		r.loadWindow(buf, head, end, sr)
+		return nil
+	}
+}
+
+// consumeWindow retires the current window, advancing the input base past its records.
+func (r *Reader) consumeWindow() {
+	r.state.windowBase += int64(len(r.state.rawBuffer))
+	r.state.lineBase += r.state.lineCount
+	r.state.lineCount = 0
+	r.state.rawBuffer = nil
+	r.resetFieldArena()
+
+	if r.state.parseResult != nil {
+		r.state.parseResult.release()
+		r.state.parseResult = nil
+	}
+	r.state.currentRecordIndex = 0
+}
+
+// loadWindow parses buf[head:end] and installs it as the current window.
+// Bytes after end are kept as the pending tail for the next window.
+func (r *Reader) loadWindow(buf []byte, head, end int, sr *scanResult) {
+	r.state.rawBuffer = buf[head:end]
+	r.state.pending = buf[end:]
+	r.state.windowCopy = ""
+
+	// Copy scan flags for fast path optimizations
+	r.state.scanResult = sr
+	r.state.hasQuotes = sr.hasQuotes
+	r.state.hasCR = sr.hasCR
+	r.state.hasEscapes = sr.hasEscapes
+	r.copyChunkHasQuote()
+
+	if r.canResumeScan(sr) {
+		// The scan is kept whole for the next window, so the parser clips it
+		// to the window instead of truncating it
+		var lines int
+		r.state.parseResult, lines = parseSegment(buf[:end], sr, head)
+		r.state.lineCount = lines
+		if head > 0 {
+			fields := r.state.parseResult.fields
+			for i := range fields {
+				fields[i].start -= uint32(head)
+			}
+		}
+	} else {
+		sr.truncate(end)
+		r.state.parseResult = r.parseWindow(r.state.rawBuffer, sr)
+		r.state.lineCount = sr.newlineCount
+
+		// Release scanResult (no longer needed after parsing)
+		sr.release()
+		r.state.scanResult = nil
+	}
+
+	// Row line numbers are window-relative; rebase them onto the whole input
+	if r.state.lineBase > 0 {
+		rows := r.state.parseResult.rows
+		for i := range rows {
+			rows[i].lineNum += r.state.lineBase
+		}
+	}
+}
+
+// canResumeScan reports whether sr can be resumed on the bytes carried into
+// the next window. Parallel scans cannot: their pieces start from the quote
+// parity of the bytes before them rather than from a checkpoint.
+func (r *Reader) canResumeScan(sr *scanResult) bool {
+	return sr != nil && sr.resumable && r.opts.chunkSize == 0
+}
+
+// carryScan returns the bytes of buf to carry into the next window, from the
+// pending tail at tailStart on, and the scan to resume on them. A resumable
+// scan is kept by carrying from a chunk boundary at or before both the tail
+// and the checkpoint, so the masks stay aligned; the records of the next
+// window then start at head. Otherwise, or if the bytes before the tail would
+// not fit in the window the tail gets, only the tail is carried.
+func (r *Reader) carryScan(buf []byte, tailStart int, sr *scanResult) (tail []byte, head int, _ *scanResult) {
+	if !r.canResumeScan(sr) || tailStart == len(buf) {
+		sr.release()
+		return buf[tailStart:], 0, nil
+	}
+	from := min(tailStart&^(simdChunkSize-1), sr.resumeChunk*simdChunkSize)
+	if len(buf)-from >= r.windowCapacity(len(buf)-tailStart) {
+		sr.release()
+		return buf[tailStart:], 0, nil
+	}
+	sr.rebase(buf, from/simdChunkSize)
+	return buf[from:], tailStart - from, sr
+}
+
+// resumeScan scans buf, resuming sr on its carried bytes if set.
+func (r *Reader) resumeScan(buf []byte, sr *scanResult) *scanResult {
+	if sr == nil {
+		return r.scanWindow(buf)
+	}
+	resumeScanDialect(buf, r.state.comma, r.quoteByte(), r.escapeByte(), sr)
+	return sr
+}
+
+// scanWindow scans buf, splitting it across goroutines when ChunkSize is set.
+// Escapes shift quote parity, so escaped input is always scanned serially.
+func (r *Reader) scanWindow(buf []byte) *scanResult {
+	escape := r.escapeByte()
+	if escape != 0 || !shouldParallelize(len(buf), r.opts.chunkSize) {
+		return scanBufferDialect(buf, r.state.comma, r.quoteByte(), escape, r.Terminator)
+	}
+	sr := scanBufferParallel(buf, r.state.comma[0], r.quoteByte(), r.Terminator, r.opts.chunkSize)
+	confirmSeparators(buf, r.state.comma, sr)
+	return sr
+}
+
+// parseWindow parses buf, splitting it across goroutines when ChunkSize is set.
+func (r *Reader) parseWindow(buf []byte, sr *scanResult) *parseResult {
+	if shouldParallelize(len(buf), r.opts.chunkSize) {
+		return parseBufferParallel(buf, sr, r.opts.chunkSize)
+	}
+	return parseBuffer(buf, sr)
+}
+
+// fillWindow reads from the source into a window starting with tail.
+func (r *Reader) fillWindow(tail []byte) ([]byte, error) {
+	buf := r.newWindowBuffer(tail)
+
+	emptyReads := 0
+	for len(buf) < cap(buf) && !r.state.sourceEOF {
+		if err := r.ctxErr(); err != nil {
+			return buf, err
+		}
+		n, err := r.source.Read(buf[len(buf):cap(buf)])
+		buf = buf[:len(buf)+n]
+		r.state.bytesRead += int64(n)
+
+		if err == io.EOF {
+			r.state.sourceEOF = true
+			break
+		}
+		if err != nil {
+			return buf, err
+		}
+
+		if n > 0 {
+			emptyReads = 0
+		} else if emptyReads++; emptyReads >= maxConsecutiveEmptyReads {
+			return buf, io.ErrNoProgress
+		}
+	}
+
+	if r.exceedsMaxInputSize(r.state.retainWindows) {
+		return buf, ErrInputTooLarge
+	}
+	return buf, nil
+}
+
+// newWindowBuffer returns a buffer starting with a copy of tail, sized by windowCapacity.
+// The previous window is reused when large enough: records returned from it
+// are copies, except with ZeroCopy.
+func (r *Reader) newWindowBuffer(tail []byte) []byte {
+	capacity := r.windowCapacity(len(tail))
+	if r.canRecycleWindow() && cap(r.state.window) >= capacity {
+		// tail lies within the old window; copy handles the overlap
+		buf := r.state.window[:len(tail)]
+		copy(buf, tail)
+		return buf
+	}
+
+	buf := make([]byte, len(tail), capacity)
+	copy(buf, tail)
+	return buf
+}
+
+// canRecycleWindow reports whether the previous window's bytes may be overwritten.
+// Only records returned by ReadAll with ZeroCopy still alias them.
+func (r *Reader) canRecycleWindow() bool {
+	return !r.opts.zeroCopy || !r.state.retainWindows
+}
+
+// resetFieldArena discards transformed field contents of the retired window.
+// The arena is reused in place only when windows are recycled.
+func (r *Reader) resetFieldArena() {
+	if r.canRecycleWindow() {
+		r.state.fieldArena = r.state.fieldArena[:0]
+		return
+	}
+	r.state.fieldArena = nil
+}
+
+// windowCapacity returns the capacity for a window that starts with tailLen carried bytes.
+// A tail filling the window means a record longer than the window, so capacity doubles.
+// Known-size sources cap the capacity so small inputs do not allocate a full window.
+func (r *Reader) windowCapacity(tailLen int) int {
+	if r.state.sourceEOF {
+		return tailLen
+	}
+
+	size := r.bufferSize()
+	for size <= tailLen {
+		size *= 2
+	}
+	if maxSize := r.maxWindowSize(); size > maxSize {
+		size = max(maxSize, tailLen+1)
+	}
+
+	if r.state.sourceSize >= 0 {
+		// One extra byte lets the fill observe io.EOF without another window
+		remaining := r.state.sourceSize - r.state.bytesRead
+		if remaining > 0 {
+			if limit := int64(tailLen) + remaining + 1; limit < int64(size) {
+				size = int(limit)
+			}
+		}
+	}
+	return size
+}
+
+// bufferSize returns the configured window size, or defaultBufferSize if unset.
+func (r *Reader) bufferSize() int {
+	if r.opts.bufferSize > 0 {
+		return r.opts.bufferSize
+	}
+	return defaultBufferSize
+}
+
+// atMaxBufferSize reports whether a window of windowLen bytes can no longer grow.
+func (r *Reader) atMaxBufferSize(windowLen int) bool {
+	return windowLen >= r.maxWindowSize()
+}
+
+// maxWindowSize returns the size a window may grow to: MaxBufferSize, or
+// DefaultMaxInputSize if unset, which keeps window positions within uint32.
+func (r *Reader) maxWindowSize() int {
+	if r.opts.maxBufferSize > 0 {
+		return r.opts.maxBufferSize
+	}
+	return DefaultMaxInputSize
+}
+
+// maxInputSize returns the limit on bytes read from the source, or 0 if none.
+// Unless MaxInputSize is set, only reads holding the whole input in memory
+// (whole) are limited, to DefaultMaxInputSize; windowed reads are bounded by
+// the window size instead.
+func (r *Reader) maxInputSize(whole bool) int64 {
+	switch {
+	case r.opts.maxInputSize > 0:
+		return r.opts.maxInputSize
+	case r.opts.maxInputSize == 0 && whole:
+		return DefaultMaxInputSize
+	}
+	return 0
+}
+
+// exceedsMaxInputSize reports whether more than the limit of maxInputSize has been read.
+func (r *Reader) exceedsMaxInputSize(whole bool) bool {
+	maxSize := r.maxInputSize(whole)
+	return maxSize > 0 && r.state.bytesRead > maxSize
+}
+
+// inputColumn returns the 1-indexed column reported for a window-relative position.
+// Columns are absolute byte offsets into the input, so the window base is added.
+func (r *Reader) inputColumn(pos uint64) int {
+	return int(r.state.windowBase) + int(pos) + 1
+}
+
+// inputOffset returns the absolute input offset of a window-relative position.
+func (r *Reader) inputOffset(pos uint64) int64 {
+	return r.state.windowBase + int64(pos)
+}
@@ -0,0 +1,320 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// =============================================================================
+// Streaming Window Tests
+// =============================================================================
+
+// generateWindowedCSV builds an input several windows long with quoted
+// multi-line fields, escaped quotes and CRLF line endings.
+func generateWindowedCSV(minSize int) string {
+	var b strings.Builder
+	for i := 0; b.Len() < minSize; i++ {
+		switch i % 4 {
+		case 0:
+			fmt.Fprintf(&b, "%d,plain,field\n", i)
+		case 1:
+			fmt.Fprintf(&b, "%d,\"multi\nline\",\"say \"\"hi\"\"\"\r\n", i)
+		case 2:
+			fmt.Fprintf(&b, "%d,\"a,b\",\"\r\n\"\n", i)
+		default:
+			fmt.Fprintf(&b, "%d,%s,end\n", i, strings.Repeat("x", i%97))
+		}
+	}
+	return b.String()
+}
+
+// readAllStdlib reads all records with encoding/csv for comparison.
+func readAllStdlib(t *testing.T, input string) [][]string {
+	t.Helper()
+	r := csv.NewReader(strings.NewReader(input))
+	r.FieldsPerRecord = -1
+	records, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("encoding/csv ReadAll error: %v", err)
+	}
+	return records
+}
+
+// TestStream_MatchesStdlib verifies records spanning window boundaries parse identically.
+func TestStream_MatchesStdlib(t *testing.T) {
+	input := generateWindowedCSV(3 * defaultBufferSize)
+	want := readAllStdlib(t, input)
+
+	sources := []struct {
+		name string
+		src  func() io.Reader
+	}{
+		{"known size", func() io.Reader { return strings.NewReader(input) }},
+		{"unknown size", func() io.Reader { return iotest.HalfReader(strings.NewReader(input)) }},
+		{"one byte reads", func() io.Reader { return iotest.OneByteReader(strings.NewReader(input)) }},
+	}
+
+	for _, tt := range sources {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReader(tt.src())
+			r.FieldsPerRecord = -1
+			got, err := r.ReadAll()
+			if err != nil {
+				t.Fatalf("ReadAll error: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("ReadAll mismatch: got %d records, want %d", len(got), len(want))
+			}
+		})
+	}
+}
+
+// TestStream_CRLFAtWindowBoundary verifies a CRLF split across windows is one terminator.
+func TestStream_CRLFAtWindowBoundary(t *testing.T) {
+	row := "abc,def\r\n"
+	prefix := strings.Repeat("x", defaultBufferSize-len(row)-1) + "\r\n"
+	input := prefix + strings.Repeat(row, 10)
+
+	// Unknown size: the first window is exactly defaultBufferSize bytes, ending in CR.
+	r := NewReader(iotest.HalfReader(strings.NewReader(input)))
+	r.FieldsPerRecord = -1
+	got, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll error: %v", err)
+	}
+	if want := readAllStdlib(t, input); !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadAll mismatch: got %d records, want %d", len(got), len(want))
+	}
+}
+
+// TestStream_RecordLongerThanWindow verifies the window grows for oversized records.
+func TestStream_RecordLongerThanWindow(t *testing.T) {
+	long := strings.Repeat("line\n", defaultBufferSize/2)
+	input := "a,b\n\"" + long + "\",tail\nc,d\n"
+
+	r := NewReader(iotest.HalfReader(strings.NewReader(input)))
+	got, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll error: %v", err)
+	}
+	want := [][]string{{"a", "b"}, {long, "tail"}, {"c", "d"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadAll mismatch: got %d records", len(got))
+	}
+}
+
+// repeatReader yields row n times without holding the whole input in memory.
+type repeatReader struct {
+	row       string
+	remaining int
+	pos       int
+}
+
+func (rr *repeatReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) && rr.remaining > 0 {
+		c := copy(p[n:], rr.row[rr.pos:])
+		n += c
+		rr.pos += c
+		if rr.pos == len(rr.row) {
+			rr.pos = 0
+			rr.remaining--
+		}
+	}
+	if n == 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+// TestStream_BoundedMemory verifies the window does not grow with input size.
+func TestStream_BoundedMemory(t *testing.T) {
+	const rows = 500000
+	src := &repeatReader{row: "12345,\"quoted, value\",plain text\n", remaining: rows}
+
+	r := NewReader(src)
+	count := 0
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Read error at record %d: %v", count, err)
+		}
+		if record[1] != "quoted, value" {
+			t.Fatalf("record %d: got %q", count, record)
+		}
+		if c := cap(r.state.rawBuffer); c > defaultBufferSize {
+			t.Fatalf("window capacity %d exceeds %d", c, defaultBufferSize)
+		}
+		count++
+	}
+	if count != rows {
+		t.Errorf("got %d records, want %d", count, rows)
+	}
+}
+
+// TestStream_LineNumbersAcrossWindows verifies error lines are absolute, not window-relative.
+func TestStream_LineNumbersAcrossWindows(t *testing.T) {
+	const badRow = 50000
+	var b strings.Builder
+	for i := 1; i < badRow; i++ {
+		b.WriteString("a,b,c\n")
+	}
+	b.WriteString("a,b\n")
+
+	r := NewReader(iotest.HalfReader(strings.NewReader(b.String())))
+	_, err := r.ReadAll()
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected ParseError, got %v", err)
+	}
+	if pe.Line != badRow || !errors.Is(err, ErrFieldCount) {
+		t.Errorf("got %v, want ErrFieldCount on line %d", err, badRow)
+	}
+}
+
+// TestStream_MaxInputSize verifies the size limit applies to streamed input.
+func TestStream_MaxInputSize(t *testing.T) {
+	input := strings.Repeat("a,b\n", 100)
+	r := NewReaderWithOptions(iotest.HalfReader(strings.NewReader(input)), ReaderOptions{MaxInputSize: 64})
+
+	_, err := r.ReadAll()
+	if !errors.Is(err, ErrInputTooLarge) {
+		t.Errorf("got %v, want ErrInputTooLarge", err)
+	}
+	if _, err := r.Read(); !errors.Is(err, ErrInputTooLarge) {
+		t.Errorf("subsequent Read: got %v, want ErrInputTooLarge", err)
+	}
+}
+
+// TestStream_DefaultMaxInputSize verifies the default size limit applies only
+// to reads that hold the whole input in memory.
+func TestStream_DefaultMaxInputSize(t *testing.T) {
+	input := generateWindowedCSV(3 * 4096)
+	want, err := NewReader(strings.NewReader(input)).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll error: %v", err)
+	}
+
+	// past2GB reads the first record and accounts DefaultMaxInputSize more bytes as read
+	past2GB := func() *Reader {
+		r := NewReaderWithOptions(iotest.HalfReader(strings.NewReader(input)), ReaderOptions{BufferSize: 4096})
+		if _, err := r.Read(); err != nil {
+			t.Fatalf("Read error: %v", err)
+		}
+		r.state.bytesRead += DefaultMaxInputSize
+		return r
+	}
+
+	r := past2GB()
+	n := 1
+	for {
+		_, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Read error after record %d: %v", n, err)
+		}
+		n++
+	}
+	if n != len(want) {
+		t.Errorf("Read returned %d records, want %d", n, len(want))
+	}
+
+	if _, err := past2GB().ReadAll(); !errors.Is(err, ErrInputTooLarge) {
+		t.Errorf("ReadAll error = %v, want ErrInputTooLarge", err)
+	}
+	if _, err := past2GB().Len(); !errors.Is(err, ErrInputTooLarge) {
+		t.Errorf("Len error = %v, want ErrInputTooLarge", err)
+	}
+}
+
+// =============================================================================
+// BufferSize Tests
+// =============================================================================
+
+// TestBufferSize_MatchesStdlib verifies small windows parse identically to encoding/csv.
+func TestBufferSize_MatchesStdlib(t *testing.T) {
+	input := generateWindowedCSV(64 * 1024)
+	want := readAllStdlib(t, input)
+
+	for _, size := range []int{1, 7, 64, 100, 4096} {
+		t.Run(fmt.Sprintf("size=%d", size), func(t *testing.T) {
+			r := NewReaderWithOptions(iotest.HalfReader(strings.NewReader(input)), ReaderOptions{BufferSize: size})
+			r.FieldsPerRecord = -1
+			got, err := r.ReadAll()
+			if err != nil {
+				t.Fatalf("ReadAll error: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("ReadAll mismatch: got %d records, want %d", len(got), len(want))
+			}
+		})
+	}
+}
+
+// TestBufferSize_BoundsWindow verifies the window stays at BufferSize when records fit.
+func TestBufferSize_BoundsWindow(t *testing.T) {
+	const bufferSize = 1024
+	src := &repeatReader{row: "a,b,c\n", remaining: 10000}
+	r := NewReaderWithOptions(src, ReaderOptions{BufferSize: bufferSize})
+
+	for {
+		_, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Read error: %v", err)
+		}
+		if c := cap(r.state.rawBuffer); c > bufferSize {
+			t.Fatalf("window capacity %d exceeds BufferSize %d", c, bufferSize)
+		}
+	}
+}
+
+// TestMaxBufferSize tests window growth limits for oversized records.
+func TestMaxBufferSize(t *testing.T) {
+	long := strings.Repeat("x", 5000)
+	input := "a,b\n" + long + ",c\nd,e\n"
+
+	tests := []struct {
+		name          string
+		maxBufferSize int
+		wantErr       error
+		wantRecords   int
+	}{
+		{name: "unlimited", maxBufferSize: 0, wantRecords: 3},
+		{name: "large enough", maxBufferSize: 8192, wantRecords: 3},
+		{name: "too small", maxBufferSize: 4096, wantErr: ErrRecordTooLarge, wantRecords: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReaderWithOptions(iotest.HalfReader(strings.NewReader(input)), ReaderOptions{
+				BufferSize:    16,
+				MaxBufferSize: tt.maxBufferSize,
+			})
+			r.FieldsPerRecord = -1
+			records, err := r.ReadAll()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReadAll error: got %v, want %v", err, tt.wantErr)
+			}
+			if len(records) != tt.wantRecords {
+				t.Errorf("got %d records, want %d", len(records), tt.wantRecords)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+//go:build goexperiment.simd && amd64
+
+package simdcsv
+
+import "bytes"
+
+// =============================================================================
+// Record Terminator
+// =============================================================================
+//
+// By default a record ends at LF, CRLF or a lone CR. Terminator narrows this
+// to a single convention, or replaces line breaks with a custom byte such as
+// ASCII RS (0x1E) used by ASCII-delimited text.
+//
+// The scanner still matches CR and LF in every chunk; recordTerminators then
+// selects which of them end a record. Strict CRLF needs the CR of a pair split
+// across chunks, which is carried through scanState.prevCR. A custom byte is
+// matched in place of LF, and CR and LF become ordinary field data.
+//
+// =============================================================================
+
+// Terminator selects the byte sequence that ends a record.
+// The zero value is TerminatorAny.
+type Terminator uint16
+
+const (
+	// TerminatorAny ends records at LF, CRLF or a lone CR.
+	TerminatorAny Terminator = iota
+
+	// TerminatorLF ends records at LF. A CR immediately before the LF is
+	// dropped, as encoding/csv does; a lone CR is field data.
+	TerminatorLF
+
+	// TerminatorCRLF ends records only at CRLF. A lone LF or CR is field data.
+	TerminatorCRLF
+
+	// TerminatorCR ends records at CR, as in classic Mac OS files. LF is field data.
+	TerminatorCR
+)
+
+// terminatorCustom flags a Terminator created by TerminatorByte; the low byte holds the terminator.
+const terminatorCustom Terminator = 0x100
+
+// TerminatorByte returns a Terminator that ends records at b, such as 0x1E
+// (ASCII record separator). CR and LF are field data.
+func TerminatorByte(b byte) Terminator {
+	return terminatorCustom | Terminator(b)
+}
+
+// customByte returns the terminator byte of a Terminator created by TerminatorByte.
+func (t Terminator) customByte() (byte, bool) {
+	if t&terminatorCustom == 0 {
+		return 0, false
+	}
+	return byte(t), true
+}
+
+// newlineByte returns the byte the scanner matches in place of LF.
+func (t Terminator) newlineByte() byte {
+	if b, ok := t.customByte(); ok {
+		return b
+	}
+	return '\n'
+}
+
+// dropsCR reports whether a CR immediately before a terminator is part of it.
+func (t Terminator) dropsCR() bool {
+	return t == TerminatorAny || t == TerminatorLF || t == TerminatorCRLF
+}
+
+// bytes returns the sequence the Writer emits after each record.
+// TerminatorAny defers to useCRLF.
+func (t Terminator) bytes(useCRLF bool) string {
+	if b, ok := t.customByte(); ok {
+		return string([]byte{b})
+	}
+	switch t {
+	case TerminatorCRLF:
+		return "\r\n"
+	case TerminatorCR:
+		return "\r"
+	case TerminatorLF:
+		return "\n"
+	}
+	if useCRLF {
+		return "\r\n"
+	}
+	return "\n"
+}
+
+// nextTerminator returns the offset just past the first record terminator in
+// b, ignoring quotes, or -1 if there is none.
+func (t Terminator) nextTerminator(b []byte) int {
+	var i int // index of the last byte of the terminator
+	switch t {
+	case TerminatorAny:
+		if i = bytes.IndexAny(b, "\r\n"); i >= 0 && b[i] == '\r' && i+1 < len(b) && b[i+1] == '\n' {
+			i++
+		}
+	case TerminatorCRLF:
+		if i = bytes.Index(b, []byte("\r\n")); i >= 0 {
+			i++
+		}
+	case TerminatorCR:
+		i = bytes.IndexByte(b, '\r')
+	default:
+		i = bytes.IndexByte(b, t.newlineByte())
+	}
+	if i < 0 {
+		return -1
+	}
+	return i + 1
+}
+
+// validTerminator reports whether t can end records with the separator sep and
+// the given quote, comment and escape characters. A custom terminator byte must
+// not be NUL and must differ from every other structural byte.
+func validTerminator(t Terminator, sep []byte, comment, quote, escape rune) bool {
+	if t <= TerminatorCR {
+		return true
+	}
+	b, ok := t.customByte()
+	if !ok || t > terminatorCustom|0xFF || b == 0 {
+		return false
+	}
+	r := rune(b)
+	return r != quote && r != comment && r != escape && bytes.IndexByte(sep, b) < 0
+}
+
+// =============================================================================
+// Terminator Scanning
+// =============================================================================
+
+// recordTerminators returns the record terminator positions of a chunk under
+// state.terminator. CRLF pairs are reported at the LF.
+func recordTerminators(cur, next chunkMasks, validBits int, state *scanState) uint64 {
+	switch state.terminator {
+	case TerminatorAny:
+		return normalizeCRLF(cur.cr, cur.nl, next.nl, validBits)
+	case TerminatorCRLF:
+		crlf := cur.nl & (cur.cr<<1 | state.prevCR)
+		state.prevCR = cur.cr >> (simdChunkSize - 1)
+		return crlf
+	case TerminatorCR:
+		return cur.cr
+	}
+	// TerminatorLF, or a custom byte generated in place of LF
+	return cur.nl
+}
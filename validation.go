@@ -2,7 +2,10 @@
 
 package simdcsv
 
-import "bytes"
+import (
+	"bytes"
+	"unicode/utf8"
+)
 
 // =============================================================================
 // Validation Policy - Configurable behavior decisions
@@ -13,6 +16,7 @@ import "bytes"
 type validationPolicy struct {
 	trimLeadingSpace bool
 	comma            rune
+	quote            byte
 }
 
 // newValidationPolicy creates a policy from Reader configuration.
@@ -20,6 +24,7 @@ func (r *Reader) newValidationPolicy() validationPolicy {
 	return validationPolicy{
 		trimLeadingSpace: r.TrimLeadingSpace,
 		comma:            r.Comma,
+		quote:            r.quoteByte(),
 	}
 }
 
@@ -66,7 +71,7 @@ func (r *Reader) dispatchFieldValidation(raw []byte, rawStart uint64, field fiel
 	}
 
 	// Determine if field is quoted (handles TrimLeadingSpace case)
-	isQuoted, quoteOffset := isQuotedFieldStart(raw, policy.trimLeadingSpace)
+	isQuoted, quoteOffset := isQuotedFieldStart(raw, policy.trimLeadingSpace, policy.quote)
 	if isQuoted {
 		adjustedRaw := raw[quoteOffset:]
 		adjustedStart := rawStart + uint64(quoteOffset) //nolint:gosec // G115
@@ -84,20 +89,22 @@ func (r *Reader) dispatchFieldValidation(raw []byte, rawStart uint64, field fiel
 // This avoids re-scanning for quotes since the parser already identified the structure.
 // raw is the full field content including quotes; rawStart is its absolute position.
 func (r *Reader) validateQuotedFieldFromMetadata(raw []byte, rawStart uint64, field fieldInfo, lineNum int) error {
+	quote := r.quoteByte()
+
 	// Step 1: Check minimum length requirement
 	if !hasMinimumLength(raw, 2) {
 		return r.quoteErrorAt(lineNum, rawStart, len(raw))
 	}
 
 	// Step 2: Verify opening quote
-	if !hasOpeningQuote(raw) {
+	if !hasOpeningQuote(raw, quote) {
 		return r.quoteErrorAt(lineNum, rawStart, 1)
 	}
 
 	// Step 3: Verify closing quote at expected position
 	// field.length is content length (between quotes), so closing quote is at length + 1
 	closingIdx := int(field.length) + 1
-	if !hasClosingQuoteAt(raw, closingIdx) {
+	if !hasClosingQuoteAt(raw, closingIdx, quote) {
 		return r.quoteErrorAt(lineNum, rawStart, min(closingIdx+1, len(raw)))
 	}
 
@@ -116,7 +123,7 @@ func (r *Reader) validateQuotedFieldFromMetadata(raw []byte, rawStart uint64, fi
 // validateQuotedField validates a field that starts with a quote.
 // raw should start with the opening quote.
 func (r *Reader) validateQuotedField(raw []byte, rawStart uint64, lineNum int) error {
-	closingQuoteIdx := findClosingQuote(raw, 1)
+	closingQuoteIdx := r.closingQuoteIndex(raw, 1)
 	if closingQuoteIdx == -1 {
 		return r.quoteErrorAt(lineNum, rawStart, len(raw))
 	}
@@ -135,12 +142,12 @@ func (r *Reader) validateQuotedField(raw []byte, rawStart uint64, lineNum int) e
 // validateUnquotedField validates a field that does not start with a quote.
 // Reports ErrBareQuote if quotes appear in unquoted fields.
 func (r *Reader) validateUnquotedField(raw []byte, rawStart uint64, lineNum int) error {
-	quotePos := bytes.IndexByte(raw, '"')
+	quotePos := bytes.IndexByte(raw, r.quoteByte())
 	if quotePos == -1 {
 		return nil
 	}
-	col := int(rawStart) + quotePos + 1 //nolint:gosec // G115
-	return &ParseError{StartLine: lineNum, Line: lineNum, Column: col, Err: ErrBareQuote}
+	pos := rawStart + uint64(quotePos) //nolint:gosec // G115
+	return r.parseErrorAt(lineNum, pos, r.fieldIndexAt(pos), ErrBareQuote)
 }
 
 // =============================================================================
@@ -152,14 +159,14 @@ func hasMinimumLength(data []byte, minLen int) bool {
 	return len(data) >= minLen
 }
 
-// hasOpeningQuote checks if the first byte is a quote character.
-func hasOpeningQuote(data []byte) bool {
-	return len(data) > 0 && data[0] == '"'
+// hasOpeningQuote checks if the first byte is the quote character.
+func hasOpeningQuote(data []byte, quote byte) bool {
+	return len(data) > 0 && data[0] == quote
 }
 
 // hasClosingQuoteAt checks if there is a quote at the expected position.
-func hasClosingQuoteAt(data []byte, closingIdx int) bool {
-	return closingIdx < len(data) && data[closingIdx] == '"'
+func hasClosingQuoteAt(data []byte, closingIdx int, quote byte) bool {
+	return closingIdx < len(data) && data[closingIdx] == quote
 }
 
 // isValidAfterClosingQuote checks that nothing unexpected follows the closing quote.
@@ -168,7 +175,13 @@ func (r *Reader) isValidAfterClosingQuote(data []byte, closingIdx int) bool {
 	if afterClose >= len(data) {
 		return true // Nothing after closing quote is valid
 	}
-	return isFieldTerminator(data[afterClose], r.Comma)
+	if isFieldTerminator(data[afterClose], r.Comma) {
+		return true
+	}
+	if b, ok := r.Terminator.customByte(); ok && data[afterClose] == b {
+		return true
+	}
+	return len(r.state.comma) > 1 && bytes.HasPrefix(data[afterClose:], r.state.comma)
 }
 
 // =============================================================================
@@ -178,6 +191,7 @@ func (r *Reader) isValidAfterClosingQuote(data []byte, closingIdx int) bool {
 // isFieldTerminator reports whether b is a valid field terminator.
 // Valid terminators are: newline (\n), carriage return (\r), or the configured comma.
 // The literal comma (',') is always accepted for backward compatibility with RFC 4180.
+// A multi-byte separator cannot be matched from a single byte; see isValidAfterClosingQuote.
 func isFieldTerminator(b byte, comma rune) bool {
 	switch b {
 	case '\n', '\r':
@@ -185,7 +199,7 @@ func isFieldTerminator(b byte, comma rune) bool {
 	case ',':
 		return true // Always accept comma for backward compatibility
 	default:
-		return b == byte(comma)
+		return comma < utf8.RuneSelf && b == byte(comma)
 	}
 }
 
@@ -196,6 +210,6 @@ func isFieldTerminator(b byte, comma rune) bool {
 // quoteErrorAt returns a ParseError for quote-related validation failures.
 // offset is the position within the field (0-indexed), added to rawStart for the column.
 func (r *Reader) quoteErrorAt(lineNum int, rawStart uint64, offset int) *ParseError {
-	col := int(rawStart) + offset //nolint:gosec // G115
-	return &ParseError{StartLine: lineNum, Line: lineNum, Column: col, Err: ErrQuote}
+	pos := rawStart + uint64(offset) - 1 //nolint:gosec // G115
+	return r.parseErrorAt(lineNum, pos, r.fieldIndexAt(pos), ErrQuote)
 }
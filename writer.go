@@ -6,7 +6,9 @@ import (
 	"bufio"
 	"io"
 	"math/bits"
+	"strconv"
 	"strings"
+	"time"
 	"unsafe"
 
 	"simd/archsimd"
@@ -20,31 +22,45 @@ import (
 // Writes are buffered; call Flush to ensure data reaches the underlying io.Writer.
 // Check Error for any errors that occurred during Write or Flush.
 type Writer struct {
-	Comma   rune // Field delimiter (set to ',' by NewWriter)
-	UseCRLF bool // Use \r\n as line terminator instead of \n
+	Comma      rune       // Field delimiter (set to ',' by NewWriter)
+	Delimiter  string     // If not empty, multi-character field delimiter used instead of Comma
+	Quote      rune       // Quote character, ASCII only (set to '"' by NewWriter; zero means '"')
+	Escape     rune       // If not 0, escape Quote, Escape, \n and \r inside quoted fields instead of doubling quotes
+	UseCRLF    bool       // Use \r\n as line terminator instead of \n
+	Terminator Terminator // If not TerminatorAny, record terminator used instead of UseCRLF
 
 	w   *bufio.Writer
 	err error
+
+	// Delimiter configuration last accepted by checkWritable
+	dialect      writerDialect
+	dialectValid bool
+
+	// Field-at-a-time state, see BeginRecord
+	fieldCount int
+	scratch    []byte // formatted number or time
 }
 
 // NewWriter returns a new Writer that writes to w.
 func NewWriter(w io.Writer) *Writer {
 	return &Writer{
 		Comma: ',',
+		Quote: defaultQuote,
 		w:     bufio.NewWriter(w),
 	}
 }
 
 // Write writes a single CSV record with necessary quoting.
 // Writes are buffered; call Flush to ensure output reaches the underlying Writer.
+// Returns ErrInvalidDelim if the delimiter, Quote, Escape and Terminator do not form a valid configuration.
 func (w *Writer) Write(record []string) error {
-	if w.err != nil {
-		return w.err
+	if err := w.checkWritable(); err != nil {
+		return err
 	}
 
 	for i, field := range record {
 		if i > 0 {
-			if _, w.err = w.w.WriteRune(w.Comma); w.err != nil {
+			if w.err = w.writeDelimiter(); w.err != nil {
 				return w.err
 			}
 		}
@@ -56,6 +72,35 @@ func (w *Writer) Write(record []string) error {
 	return w.writeLineEnding()
 }
 
+// checkWritable returns the sticky error of a previous write, or
+// ErrInvalidDelim if the delimiter configuration is invalid.
+func (w *Writer) checkWritable() error {
+	if w.err != nil {
+		return w.err
+	}
+	d := writerDialect{comma: w.Comma, delimiter: w.Delimiter, quote: quoteOrDefault(w.Quote), escape: w.Escape, terminator: w.Terminator}
+	if w.dialectValid && d == w.dialect {
+		return nil
+	}
+	sep, ok := separatorBytes(d.comma, d.delimiter, 0, d.quote, d.escape)
+	if !ok || !validTerminator(d.terminator, sep, 0, d.quote, d.escape) {
+		return ErrInvalidDelim
+	}
+	w.dialect, w.dialectValid = d, true
+	return nil
+}
+
+// writerDialect is the delimiter configuration of a Writer. Fields may change
+// between writes, so checkWritable validates a configuration only when it
+// differs from the last valid one, keeping writes free of allocations.
+type writerDialect struct {
+	comma      rune
+	delimiter  string
+	quote      rune
+	escape     rune
+	terminator Terminator
+}
+
 // writeField writes a single field, quoting if necessary.
 func (w *Writer) writeField(field string) error {
 	if w.fieldNeedsQuotes(field) {
@@ -65,16 +110,100 @@ func (w *Writer) writeField(field string) error {
 	return err
 }
 
-// writeLineEnding writes \r\n or \n based on UseCRLF setting.
-func (w *Writer) writeLineEnding() error {
-	if w.UseCRLF {
-		_, w.err = w.w.WriteString("\r\n")
-	} else {
-		w.err = w.w.WriteByte('\n')
+// writeDelimiter writes Delimiter, or Comma if Delimiter is empty.
+func (w *Writer) writeDelimiter() error {
+	if w.Delimiter != "" {
+		_, err := w.w.WriteString(w.Delimiter)
+		return err
 	}
+	_, err := w.w.WriteRune(w.Comma)
+	return err
+}
+
+// quoteByte returns the configured quote character.
+func (w *Writer) quoteByte() byte {
+	return byte(quoteOrDefault(w.Quote))
+}
+
+// writeLineEnding writes the Terminator sequence, or \r\n or \n based on UseCRLF setting.
+func (w *Writer) writeLineEnding() error {
+	_, w.err = w.w.WriteString(w.Terminator.bytes(w.UseCRLF))
 	return w.err
 }
 
+// ============================================================================
+// Field-at-a-Time Writing
+// ============================================================================
+
+// BeginRecord starts a record that is written one field at a time with the
+// WriteField and Write<Type> methods and terminated by EndRecord. Fields are
+// quoted exactly as by Write; numbers are formatted without allocating.
+// Returns the error of a previous write, or ErrInvalidDelim as Write does.
+func (w *Writer) BeginRecord() error {
+	w.fieldCount = 0
+	return w.checkWritable()
+}
+
+// WriteField writes the next field of the current record, quoting it if necessary.
+// Errors are reported by EndRecord.
+func (w *Writer) WriteField(field string) {
+	if w.err != nil {
+		return
+	}
+	if w.fieldCount > 0 {
+		if w.err = w.writeDelimiter(); w.err != nil {
+			return
+		}
+	}
+	w.fieldCount++
+	w.err = w.writeField(field)
+}
+
+// WriteFieldBytes is like WriteField but takes the field as a byte slice.
+func (w *Writer) WriteFieldBytes(field []byte) {
+	w.WriteField(unsafe.String(unsafe.SliceData(field), len(field)))
+}
+
+// WriteInt writes v in decimal as the next field.
+func (w *Writer) WriteInt(v int64) {
+	w.scratch = strconv.AppendInt(w.scratch[:0], v, 10)
+	w.WriteFieldBytes(w.scratch)
+}
+
+// WriteUint writes v in decimal as the next field.
+func (w *Writer) WriteUint(v uint64) {
+	w.scratch = strconv.AppendUint(w.scratch[:0], v, 10)
+	w.WriteFieldBytes(w.scratch)
+}
+
+// WriteFloat writes v in decimal notation as the next field, with prec digits
+// after the point, or the fewest digits that represent v exactly if prec is -1.
+// bitSize is 32 for float32 and 64 for float64 values.
+func (w *Writer) WriteFloat(v float64, prec, bitSize int) {
+	w.scratch = strconv.AppendFloat(w.scratch[:0], v, 'f', prec, bitSize)
+	w.WriteFieldBytes(w.scratch)
+}
+
+// WriteBool writes "true" or "false" as the next field.
+func (w *Writer) WriteBool(v bool) {
+	w.scratch = strconv.AppendBool(w.scratch[:0], v)
+	w.WriteFieldBytes(w.scratch)
+}
+
+// WriteTime writes t formatted with layout as the next field.
+func (w *Writer) WriteTime(t time.Time, layout string) {
+	w.scratch = t.AppendFormat(w.scratch[:0], layout)
+	w.WriteFieldBytes(w.scratch)
+}
+
+// EndRecord terminates the current record and returns any error from writing its fields.
+func (w *Writer) EndRecord() error {
+	if w.err != nil {
+		return w.err
+	}
+	return w.writeLineEnding()
+}
+
 // WriteAll writes multiple records and calls Flush.
 func (w *Writer) WriteAll(records [][]string) error {
 	for _, record := range records {
@@ -113,6 +242,17 @@ func (w *Writer) fieldNeedsQuotes(field string) bool {
 	if field[0] == ' ' || field[0] == '\t' {
 		return true
 	}
+	// An unquoted escape would be decoded by the reader
+	if w.Escape != 0 && strings.IndexByte(field, byte(w.Escape)) >= 0 {
+		return true
+	}
+	// A custom terminator would end the record
+	if b, ok := w.Terminator.customByte(); ok && strings.IndexByte(field, b) >= 0 {
+		return true
+	}
+	if w.Delimiter != "" {
+		return w.fieldNeedsQuotesDelimiter(field)
+	}
 	// Use SIMD only for larger fields where the overhead is justified
 	if useAVX512 && len(field) >= writerSIMDCheckThreshold && w.Comma >= 0 && w.Comma < 128 {
 		return w.fieldNeedsQuotesSIMD(field)
@@ -123,12 +263,13 @@ func (w *Writer) fieldNeedsQuotes(field string) bool {
 // fieldNeedsQuotesScalar checks for special characters using direct byte iteration.
 // This is faster than strings.ContainsAny for short strings due to charset building overhead.
 func (w *Writer) fieldNeedsQuotesScalar(field string) bool {
+	quote := w.quoteByte()
 	// For ASCII comma (common case), use direct byte comparison
 	if w.Comma < 128 {
 		comma := byte(w.Comma)
 		for i := 0; i < len(field); i++ {
 			c := field[i]
-			if c == comma || c == '\n' || c == '\r' || c == '"' {
+			if c == comma || c == '\n' || c == '\r' || c == quote {
 				return true
 			}
 		}
@@ -136,7 +277,28 @@ func (w *Writer) fieldNeedsQuotesScalar(field string) bool {
 	}
 	// For non-ASCII comma, fall back to rune iteration
 	for _, c := range field {
-		if c == w.Comma || c == '\n' || c == '\r' || c == '"' {
+		if c == w.Comma || c == '\n' || c == '\r' || c == rune(quote) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldNeedsQuotesDelimiter checks for special characters when Delimiter is set.
+// Besides containing Delimiter, a field needs quotes if its tail and the
+// following Delimiter would match earlier than the Delimiter itself, as a
+// field ending in '|' does before "||".
+func (w *Writer) fieldNeedsQuotesDelimiter(field string) bool {
+	if strings.ContainsAny(field, "\r\n") || strings.IndexByte(field, w.quoteByte()) >= 0 {
+		return true
+	}
+	d := w.Delimiter
+	if strings.Contains(field, d) {
+		return true
+	}
+	for i := 1; i < len(d) && i <= len(field); i++ {
+		// A match starting i bytes before the end of field
+		if strings.HasSuffix(field, d[:i]) && d[i:] == d[:len(d)-i] {
 			return true
 		}
 	}
@@ -150,6 +312,7 @@ func (w *Writer) fieldNeedsQuotesSIMD(field string) bool {
 	int8Data := bytesToInt8Slice(data)
 
 	commaCmp := cachedSepCmp[w.Comma]
+	quoteCmp := cachedSepCmp[w.quoteByte()]
 
 	// Process 64-byte chunks using AVX-512
 	offset := 0
@@ -159,7 +322,7 @@ func (w *Writer) fieldNeedsQuotesSIMD(field string) bool {
 		commaMask := chunk.Equal(commaCmp).ToBits()
 		newlineMask := chunk.Equal(cachedNlCmp).ToBits()
 		crMask := chunk.Equal(cachedCrCmp).ToBits()
-		quoteMask := chunk.Equal(cachedQuoteCmp).ToBits()
+		quoteMask := chunk.Equal(quoteCmp).ToBits()
 
 		if commaMask|newlineMask|crMask|quoteMask != 0 {
 			return true
@@ -175,7 +338,7 @@ func (w *Writer) fieldNeedsQuotesSIMD(field string) bool {
 		commaMask := chunk.Equal(commaCmp).ToBits()
 		newlineMask := chunk.Equal(cachedNlCmp).ToBits()
 		crMask := chunk.Equal(cachedCrCmp).ToBits()
-		quoteMask := chunk.Equal(cachedQuoteCmp).ToBits()
+		quoteMask := chunk.Equal(quoteCmp).ToBits()
 
 		// Mask out bits beyond valid data
 		validBits := len(remaining)
@@ -187,26 +350,64 @@ func (w *Writer) fieldNeedsQuotesSIMD(field string) bool {
 	return false
 }
 
-// writeQuotedField writes a field surrounded by quotes, escaping internal quotes.
+// writeQuotedField writes a field surrounded by quotes, escaping internal quotes by doubling.
 func (w *Writer) writeQuotedField(field string) error {
-	if err := w.w.WriteByte('"'); err != nil {
+	quote := w.quoteByte()
+	if err := w.w.WriteByte(quote); err != nil {
 		return err
 	}
+	if w.Escape != 0 {
+		return w.writeEscapedField(field, quote, byte(w.Escape))
+	}
 	// Use SIMD for fields that benefit from parallel quote detection
 	if useAVX512 && len(field) >= writerSIMDMinSize {
-		return w.writeQuotedFieldSIMD(field)
+		return w.writeQuotedFieldSIMD(field, quote)
+	}
+	return w.writeQuotedFieldScalar(field, quote)
+}
+
+// writeEscapedField writes the body and closing quote of a quoted field in the
+// backslash-escape dialect: Quote and Escape are preceded by Escape, and line
+// breaks are written as \n and \r so that every record stays on one line.
+func (w *Writer) writeEscapedField(field string, quote, escape byte) error {
+	lastWritten := 0
+	for i := 0; i < len(field); i++ {
+		var seq byte
+		switch c := field[i]; c {
+		case quote, escape:
+			seq = c
+		case '\n':
+			seq = 'n'
+		case '\r':
+			seq = 'r'
+		default:
+			continue
+		}
+		if _, err := w.w.WriteString(field[lastWritten:i]); err != nil {
+			return err
+		}
+		if err := w.w.WriteByte(escape); err != nil {
+			return err
+		}
+		if err := w.w.WriteByte(seq); err != nil {
+			return err
+		}
+		lastWritten = i + 1
+	}
+	if _, err := w.w.WriteString(field[lastWritten:]); err != nil {
+		return err
 	}
-	return w.writeQuotedFieldScalar(field)
+	return w.w.WriteByte(quote)
 }
 
 // writeQuotedFieldScalar escapes quotes using optimized batch writing.
 // Instead of writing character by character, it finds quotes using IndexByte
 // and writes chunks between quotes in single WriteString calls.
-func (w *Writer) writeQuotedFieldScalar(field string) error {
+func (w *Writer) writeQuotedFieldScalar(field string, quote byte) error {
 	lastWritten := 0
 	for i := 0; i < len(field); {
 		// Find next quote position from current offset
-		idx := strings.IndexByte(field[i:], '"')
+		idx := strings.IndexByte(field[i:], quote)
 		if idx == -1 {
 			break // No more quotes in remaining string
 		}
@@ -215,7 +416,7 @@ func (w *Writer) writeQuotedFieldScalar(field string) error {
 		if _, err := w.w.WriteString(field[lastWritten : quotePos+1]); err != nil {
 			return err
 		}
-		if err := w.w.WriteByte('"'); err != nil {
+		if err := w.w.WriteByte(quote); err != nil {
 			return err
 		}
 		lastWritten = quotePos + 1
@@ -227,14 +428,15 @@ func (w *Writer) writeQuotedFieldScalar(field string) error {
 			return err
 		}
 	}
-	return w.w.WriteByte('"')
+	return w.w.WriteByte(quote)
 }
 
 // writeQuotedFieldSIMD escapes quotes using AVX-512 SIMD to find quote positions.
 // Handles any field size >= writerSIMDMinSize using padded operations for partial chunks.
-func (w *Writer) writeQuotedFieldSIMD(field string) error {
+func (w *Writer) writeQuotedFieldSIMD(field string, quote byte) error {
 	data := unsafe.Slice(unsafe.StringData(field), len(field))
 	int8Data := bytesToInt8Slice(data)
+	quoteCmp := cachedSepCmp[quote]
 
 	offset := 0
 	lastWritten := 0
@@ -242,7 +444,7 @@ func (w *Writer) writeQuotedFieldSIMD(field string) error {
 	// Process 64-byte chunks using AVX-512
 	for offset+simdChunkSize <= len(data) {
 		chunk := archsimd.LoadInt8x64Slice(int8Data[offset : offset+simdChunkSize])
-		mask := chunk.Equal(cachedQuoteCmp).ToBits()
+		mask := chunk.Equal(quoteCmp).ToBits()
 
 		for mask != 0 {
 			pos := bits.TrailingZeros64(mask)
@@ -252,7 +454,7 @@ func (w *Writer) writeQuotedFieldSIMD(field string) error {
 			if _, err := w.w.WriteString(field[lastWritten : quotePos+1]); err != nil {
 				return err
 			}
-			if err := w.w.WriteByte('"'); err != nil {
+			if err := w.w.WriteByte(quote); err != nil {
 				return err
 			}
 
@@ -266,7 +468,7 @@ func (w *Writer) writeQuotedFieldSIMD(field string) error {
 	if offset < len(data) {
 		remaining := data[offset:]
 		chunk := archsimd.LoadInt8x64SlicePart(bytesToInt8Slice(remaining))
-		mask := chunk.Equal(cachedQuoteCmp).ToBits()
+		mask := chunk.Equal(quoteCmp).ToBits()
 
 		// Mask out bits beyond valid data
 		validBits := len(remaining)
@@ -281,7 +483,7 @@ func (w *Writer) writeQuotedFieldSIMD(field string) error {
 			if _, err := w.w.WriteString(field[lastWritten : quotePos+1]); err != nil {
 				return err
 			}
-			if err := w.w.WriteByte('"'); err != nil {
+			if err := w.w.WriteByte(quote); err != nil {
 				return err
 			}
 
@@ -296,5 +498,5 @@ func (w *Writer) writeQuotedFieldSIMD(field string) error {
 			return err
 		}
 	}
-	return w.w.WriteByte('"')
+	return w.w.WriteByte(quote)
 }
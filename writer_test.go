@@ -4,6 +4,9 @@ package simdcsv
 
 import (
 	"bytes"
+	"errors"
+	"io"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -288,3 +291,229 @@ func TestWriteQuotedField_SIMD(t *testing.T) {
 		})
 	}
 }
+
+// =============================================================================
+// Quote Tests
+// =============================================================================
+
+// TestWrite_Quote tests writing with a custom quote character.
+func TestWrite_Quote(t *testing.T) {
+	long := strings.Repeat("x", 70)
+	tests := []struct {
+		name   string
+		record []string
+		want   string
+	}{
+		{"comma needs quotes", []string{"a,b", "c"}, "'a,b',c\n"},
+		{"quote doubled", []string{"it's"}, "'it''s'\n"},
+		{"double quote is literal", []string{`say "hi"`}, `say "hi"` + "\n"},
+		{"long field with quote", []string{long + "'" + long}, "'" + long + "''" + long + "'\n"},
+		{"long field without special", []string{long + `"`}, long + `"` + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewWriter(&buf)
+			w.Quote = '\''
+			if err := w.Write(tt.record); err != nil {
+				t.Fatalf("Write error: %v", err)
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatalf("Flush error: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+
+			r := NewReader(strings.NewReader(buf.String()))
+			r.Quote = '\''
+			got, err := r.Read()
+			if err != nil {
+				t.Fatalf("Read error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.record) {
+				t.Errorf("round trip: got %q, want %q", got, tt.record)
+			}
+		})
+	}
+}
+
+// TestWrite_InvalidQuote tests that invalid Comma and Quote combinations are rejected.
+func TestWrite_InvalidQuote(t *testing.T) {
+	for _, quote := range []rune{',', '\n', 'é'} {
+		w := NewWriter(&bytes.Buffer{})
+		w.Quote = quote
+		if err := w.Write([]string{"a"}); !errors.Is(err, ErrInvalidDelim) {
+			t.Errorf("Write with Quote %q: got %v, want ErrInvalidDelim", quote, err)
+		}
+	}
+}
+
+// =============================================================================
+// Escape Tests
+// =============================================================================
+
+// TestWrite_Escape tests writing in the backslash-escape dialect.
+func TestWrite_Escape(t *testing.T) {
+	tests := []struct {
+		name   string
+		record []string
+		want   string
+	}{
+		{"plain", []string{"a", "b"}, "a,b\n"},
+		{"quote escaped", []string{`say "hi"`}, `"say \"hi\""` + "\n"},
+		{"escape escaped", []string{`C:\tmp`, "x"}, `"C:\\tmp",x` + "\n"},
+		{"line breaks", []string{"a\r\nb"}, `"a\r\nb"` + "\n"},
+		{"comma quoted", []string{"a,b"}, `"a,b"` + "\n"},
+		{"literal backslash N", []string{`\N`}, `"\\N"` + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewWriter(&buf)
+			w.Escape = '\\'
+			if err := w.Write(tt.record); err != nil {
+				t.Fatalf("Write error: %v", err)
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatalf("Flush error: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	w := NewWriter(&bytes.Buffer{})
+	w.Escape = '"'
+	if err := w.Write([]string{"a"}); !errors.Is(err, ErrInvalidDelim) {
+		t.Errorf("Write with Escape equal to Quote: got %v, want ErrInvalidDelim", err)
+	}
+}
+
+// =============================================================================
+// Delimiter Tests
+// =============================================================================
+
+// TestWrite_Delimiter tests writing with a multi-character Delimiter.
+func TestWrite_Delimiter(t *testing.T) {
+	tests := []struct {
+		name      string
+		delimiter string
+		record    []string
+		want      string
+	}{
+		{"plain", "||", []string{"a", "b|c", "d"}, "a||b|c||d\n"},
+		{"contains delimiter", "||", []string{"a||b", "c"}, `"a||b"||c` + "\n"},
+		{"trailing pipe", "||", []string{"a|", "b"}, `"a|"||b` + "\n"},
+		{"leading pipe", "||", []string{"a", "|b"}, "a|||b\n"},
+		{"tilde pipe tilde", "~|~", []string{"a~", "b~|", "c"}, `a~~|~"b~|"~|~c` + "\n"},
+		{"comma is data", "||", []string{"a,b"}, "a,b\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewWriter(&buf)
+			w.Delimiter = tt.delimiter
+			if err := w.Write(tt.record); err != nil {
+				t.Fatalf("Write error: %v", err)
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatalf("Flush error: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+
+			r := NewReader(strings.NewReader(buf.String()))
+			r.Delimiter = tt.delimiter
+			got, err := r.Read()
+			if err != nil {
+				t.Fatalf("Read error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.record) {
+				t.Errorf("round trip: got %q, want %q", got, tt.record)
+			}
+		})
+	}
+}
+
+// =============================================================================
+// Terminator Tests
+// =============================================================================
+
+// TestWrite_Terminator tests the Writer record terminator setting.
+func TestWrite_Terminator(t *testing.T) {
+	tests := []struct {
+		name       string
+		terminator Terminator
+		useCRLF    bool
+		want       string
+	}{
+		{"any", TerminatorAny, false, "a,b\n"},
+		{"any with UseCRLF", TerminatorAny, true, "a,b\r\n"},
+		{"LF overrides UseCRLF", TerminatorLF, true, "a,b\n"},
+		{"CRLF", TerminatorCRLF, false, "a,b\r\n"},
+		{"CR", TerminatorCR, false, "a,b\r"},
+		{"RS", TerminatorByte(0x1E), false, "a,b\x1e"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewWriter(&buf)
+			w.Terminator = tt.terminator
+			w.UseCRLF = tt.useCRLF
+			if err := w.WriteAll([][]string{{"a", "b"}}); err != nil {
+				t.Fatalf("WriteAll error: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("field containing custom terminator is quoted", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewWriter(&buf)
+		w.Terminator = TerminatorByte(0x1E)
+		if err := w.WriteAll([][]string{{"a\x1eb", "c"}}); err != nil {
+			t.Fatalf("WriteAll error: %v", err)
+		}
+		if got, want := buf.String(), "\"a\x1eb\",c\x1e"; got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("invalid terminator", func(t *testing.T) {
+		w := NewWriter(&bytes.Buffer{})
+		w.Terminator = TerminatorByte(',')
+		if err := w.Write([]string{"a"}); !errors.Is(err, ErrInvalidDelim) {
+			t.Errorf("got %v, want ErrInvalidDelim", err)
+		}
+	})
+}
+
+// TestWrite_NoAllocs verifies the delimiter check is not repeated on every record,
+// and that a configuration changed between writes is still validated.
+func TestWrite_NoAllocs(t *testing.T) {
+	w := NewWriter(io.Discard)
+	w.Delimiter = "||"
+	record := []string{"a", "b c", "d"}
+	allocs := testing.AllocsPerRun(1000, func() {
+		if err := w.Write(record); err != nil {
+			t.Fatalf("Write error: %v", err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Write allocated %v times per record, want 0", allocs)
+	}
+
+	w.Delimiter = "a\nb"
+	if err := w.Write(record); !errors.Is(err, ErrInvalidDelim) {
+		t.Errorf("Write after changing Delimiter: got %v, want ErrInvalidDelim", err)
+	}
+}